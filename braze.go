@@ -14,28 +14,63 @@ import (
 const (
 	defaultBaseURL   = "https://rest.iad-05.braze.com"
 	defaultUserAgent = "go-braze"
+
+	// Date format used by Braze for date-only query parameters.
+	dateFormat = "2006-01-02"
+)
+
+var (
+	SortDirectionAsc  SortDirection = "asc"
+	SortDirectionDesc SortDirection = "desc"
 )
 
+type SortDirection string
+
 // Braze defines the Braze REST API client interface.
 type Braze interface {
 	Users() UsersEndpoint
 	Messaging() MessagingEndpoint
 	PreferenceCenter() PreferenceCenterEndpoint
+	Sends() SendsEndpoint
+	Email() EmailEndpoint
+	Subscription() SubscriptionEndpoint
+	Templates() TemplatesEndpoint
+	ContentBlocks() ContentBlocksEndpoint
+	Catalogs() CatalogsEndpoint
+	Campaigns() CampaignsEndpoint
+	Canvas() CanvasEndpoint
+	Segments() SegmentsEndpoint
+	KPI() KPIEndpoint
+	Events() EventsEndpoint
+	Purchases() PurchasesEndpoint
+	Sessions() SessionsEndpoint
+	SMS() SMSEndpoint
 }
 
 // Client implements Braze REST API client.
 type Client struct {
 	// TODO
 	// Export ExportService
-	// Email EmailService
-	// Subscription SubscriptionService
-	// Templates    TemplatesService
 
 	http *httpClient
 
 	messaging        MessagingEndpoint
 	users            UsersEndpoint
 	preferenceCenter PreferenceCenterEndpoint
+	sends            SendsEndpoint
+	email            EmailEndpoint
+	subscription     SubscriptionEndpoint
+	templates        TemplatesEndpoint
+	contentBlocks    ContentBlocksEndpoint
+	catalogs         CatalogsEndpoint
+	campaigns        CampaignsEndpoint
+	canvas           CanvasEndpoint
+	segments         SegmentsEndpoint
+	kpi              KPIEndpoint
+	events           EventsEndpoint
+	purchases        PurchasesEndpoint
+	sessions         SessionsEndpoint
+	sms              SMSEndpoint
 }
 
 type httpClient struct {
@@ -57,6 +92,62 @@ func (c *Client) PreferenceCenter() PreferenceCenterEndpoint {
 	return c.preferenceCenter
 }
 
+func (c *Client) Sends() SendsEndpoint {
+	return c.sends
+}
+
+func (c *Client) Email() EmailEndpoint {
+	return c.email
+}
+
+func (c *Client) Subscription() SubscriptionEndpoint {
+	return c.subscription
+}
+
+func (c *Client) Templates() TemplatesEndpoint {
+	return c.templates
+}
+
+func (c *Client) ContentBlocks() ContentBlocksEndpoint {
+	return c.contentBlocks
+}
+
+func (c *Client) Catalogs() CatalogsEndpoint {
+	return c.catalogs
+}
+
+func (c *Client) Campaigns() CampaignsEndpoint {
+	return c.campaigns
+}
+
+func (c *Client) Canvas() CanvasEndpoint {
+	return c.canvas
+}
+
+func (c *Client) Segments() SegmentsEndpoint {
+	return c.segments
+}
+
+func (c *Client) KPI() KPIEndpoint {
+	return c.kpi
+}
+
+func (c *Client) Events() EventsEndpoint {
+	return c.events
+}
+
+func (c *Client) Purchases() PurchasesEndpoint {
+	return c.purchases
+}
+
+func (c *Client) Sessions() SessionsEndpoint {
+	return c.sessions
+}
+
+func (c *Client) SMS() SMSEndpoint {
+	return c.sms
+}
+
 // NewClient sets up a new Braze client.
 func NewClient(opts ...ClientOption) (*Client, error) {
 	baseURL, _ := url.Parse(defaultBaseURL)
@@ -87,6 +178,62 @@ func NewClient(opts ...ClientOption) (*Client, error) {
 		client: c,
 	}
 
+	c.sends = &SendsService{
+		client: c,
+	}
+
+	c.email = &EmailService{
+		client: c,
+	}
+
+	c.subscription = &SubscriptionService{
+		client: c,
+	}
+
+	c.templates = &TemplatesService{
+		client: c,
+	}
+
+	c.contentBlocks = &ContentBlocksService{
+		client: c,
+	}
+
+	c.catalogs = &CatalogsService{
+		client: c,
+	}
+
+	c.campaigns = &CampaignsService{
+		client: c,
+	}
+
+	c.canvas = &CanvasService{
+		client: c,
+	}
+
+	c.segments = &SegmentsService{
+		client: c,
+	}
+
+	c.kpi = &KPIService{
+		client: c,
+	}
+
+	c.events = &EventsService{
+		client: c,
+	}
+
+	c.purchases = &PurchasesService{
+		client: c,
+	}
+
+	c.sessions = &SessionsService{
+		client: c,
+	}
+
+	c.sms = &SMSService{
+		client: c,
+	}
+
 	return c, nil
 }
 
@@ -159,24 +306,42 @@ func (c *httpClient) newRequest(method string, path string, body any) (*http.Req
 	return req, nil
 }
 
+// newQueryRequest creates a request without a body, passing parameters in the query string.
+func (c *httpClient) newQueryRequest(method string, path string, query url.Values) (*http.Request, error) {
+	req, err := c.newRequest(method, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = query.Encode()
+
+	return req, nil
+}
+
 func (c *httpClient) do(ctx context.Context, req *http.Request, v any) error {
+	_, err := c.doResponse(ctx, req, v)
+	return err
+}
+
+// doResponse is like do, but also returns the response for callers that need
+// its headers. The body is already consumed and closed.
+func (c *httpClient) doResponse(ctx context.Context, req *http.Request, v any) (*http.Response, error) {
 	resp, err := c.httpClient.Do(req.WithContext(ctx))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if err := parseError(resp); err != nil {
-		return err
+		return nil, err
 	}
 
 	if resp.ContentLength != 0 && v != nil {
 		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	return nil
+	return resp, nil
 }
 
 // Only errors will be parsed into ErrorResponse.
@@ -230,6 +395,13 @@ type Error struct {
 	Type       string `json:"type,omitempty"`
 	InputArray string `json:"input_array,omitempty"`
 	Index      int    `json:"index,omitempty"`
+
+	// Catalog endpoints report errors with an identifier, a message and the
+	// offending parameters instead.
+	ID              string   `json:"id,omitempty"`
+	Message         string   `json:"message,omitempty"`
+	Parameters      []string `json:"parameters,omitempty"`
+	ParameterValues []any    `json:"parameter_values,omitempty"`
 }
 
 type ExportedUser struct {
@@ -242,6 +414,18 @@ type UserExportResponse struct {
 	InvalidUserIds []string       `json:"invalid_user_ids,omitempty"`
 }
 
+// chunk splits s into consecutive slices of at most n elements.
+func chunk[T any](s []T, n int) [][]T {
+	chunks := make([][]T, 0, (len(s)+n-1)/n)
+	for n < len(s) {
+		s, chunks = s[n:], append(chunks, s[:n:n])
+	}
+	if len(s) != 0 {
+		chunks = append(chunks, s)
+	}
+	return chunks
+}
+
 // Bool is a helper routine that allocates a new bool value
 // to store v and returns a pointer to it.
 func Bool(v bool) *bool { return &v }
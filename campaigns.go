@@ -0,0 +1,247 @@
+package braze
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	campaignsListPath       = "/campaigns/list"
+	campaignsDetailsPath    = "/campaigns/details"
+	campaignsDataSeriesPath = "/campaigns/data_series"
+
+	campaignsListPageSize = 100
+)
+
+type CampaignsEndpoint interface {
+	List(ctx context.Context, r *CampaignListRequest) (*CampaignListResponse, error)
+	Details(ctx context.Context, campaignID string) (*CampaignDetails, error)
+	DataSeries(ctx context.Context, r *CampaignDataSeriesRequest) (*CampaignDataSeriesResponse, error)
+
+	// Campaigns returns an iterator over all campaigns matching the request,
+	// starting at its page.
+	Campaigns(r *CampaignListRequest) *Iterator[*CampaignSummary]
+}
+
+var _ CampaignsEndpoint = (*CampaignsService)(nil)
+
+type CampaignsService struct {
+	client *Client
+}
+
+// https://www.braze.com/docs/api/endpoints/export/campaigns/get_campaigns/
+type CampaignListRequest struct {
+	// Page of campaigns to return, starting at 0. Each page has up to 100
+	// campaigns.
+	Page            int
+	IncludeArchived bool
+	SortDirection   *SortDirection
+
+	// Only return campaigns edited after this time.
+	LastEditedAfter *time.Time
+}
+
+func (r *CampaignListRequest) values() url.Values {
+	v := url.Values{}
+	if r.Page != 0 {
+		v.Set("page", strconv.Itoa(r.Page))
+	}
+	if r.IncludeArchived {
+		v.Set("include_archived", "true")
+	}
+	if r.SortDirection != nil {
+		v.Set("sort_direction", string(*r.SortDirection))
+	}
+	if r.LastEditedAfter != nil {
+		v.Set("last_edit.time[gt]", r.LastEditedAfter.Format(time.RFC3339))
+	}
+	return v
+}
+
+type CampaignListResponse struct {
+	Message   string             `json:"message,omitempty"`
+	Campaigns []*CampaignSummary `json:"campaigns,omitempty"`
+}
+
+type CampaignSummary struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	IsAPICampaign bool      `json:"is_api_campaign"`
+	Tags          []string  `json:"tags,omitempty"`
+	LastEdited    time.Time `json:"last_edited"`
+}
+
+// https://www.braze.com/docs/api/endpoints/export/campaigns/get_campaign_details/
+type CampaignDetails struct {
+	Message            string     `json:"message,omitempty"`
+	Name               string     `json:"name"`
+	Description        string     `json:"description,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+	Archived           bool       `json:"archived"`
+	Draft              bool       `json:"draft"`
+	Enabled            bool       `json:"enabled"`
+	HasPostLaunchDraft bool       `json:"has_post_launch_draft"`
+	ScheduleType       string     `json:"schedule_type"`
+	Channels           []string   `json:"channels,omitempty"`
+	FirstSent          *time.Time `json:"first_sent,omitempty"`
+	LastSent           *time.Time `json:"last_sent,omitempty"`
+	Tags               []string   `json:"tags,omitempty"`
+
+	// Messages keyed by message variation ID.
+	Messages            map[string]*CampaignMessage   `json:"messages,omitempty"`
+	ConversionBehaviors []*CampaignConversionBehavior `json:"conversion_behaviors,omitempty"`
+}
+
+// CampaignMessage describes a single message variation. Only the fields
+// relevant to the channel are populated.
+type CampaignMessage struct {
+	Channel string `json:"channel"`
+	Name    string `json:"name"`
+	Alert   string `json:"alert,omitempty"`
+	Title   string `json:"title,omitempty"`
+	Subject string `json:"subject,omitempty"`
+	Body    string `json:"body,omitempty"`
+	From    string `json:"from,omitempty"`
+	ReplyTo string `json:"reply_to,omitempty"`
+}
+
+type CampaignConversionBehavior struct {
+	Type string `json:"type"`
+
+	// Conversion deadline in seconds.
+	Window          int    `json:"window"`
+	CustomEventName string `json:"custom_event_name,omitempty"`
+	Product         string `json:"product,omitempty"`
+}
+
+// https://www.braze.com/docs/api/endpoints/export/campaigns/get_campaign_analytics/
+type CampaignDataSeriesRequest struct {
+	CampaignID string
+
+	// Max number of days before EndingAt to include in the returned series.
+	// Must be between 1 and 100 (inclusive).
+	Length int
+
+	// Date on which the data series should end. Defaults to time of the request.
+	EndingAt *time.Time
+}
+
+func (r *CampaignDataSeriesRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.CampaignID == "" {
+		return errors.New("campaign ID must not be empty")
+	}
+
+	if r.Length < 1 || r.Length > 100 {
+		return errors.New("length must be between 1 and 100")
+	}
+
+	return nil
+}
+
+func (r *CampaignDataSeriesRequest) values() url.Values {
+	v := url.Values{}
+	v.Set("campaign_id", r.CampaignID)
+	v.Set("length", strconv.Itoa(r.Length))
+	if r.EndingAt != nil {
+		v.Set("ending_at", r.EndingAt.Format(time.RFC3339))
+	}
+	return v
+}
+
+type CampaignDataSeriesResponse struct {
+	Message string                     `json:"message,omitempty"`
+	Data    []*CampaignDataSeriesEntry `json:"data,omitempty"`
+}
+
+type CampaignDataSeriesEntry struct {
+	// Date of the entry in yyyy-MM-dd format.
+	Time                  string             `json:"time"`
+	Messages              *ChannelStatistics `json:"messages,omitempty"`
+	ConversionsBySendTime int                `json:"conversions_by_send_time"`
+	Conversions           int                `json:"conversions"`
+	Conversions1          int                `json:"conversions1"`
+	Conversions2          int                `json:"conversions2"`
+	Conversions3          int                `json:"conversions3"`
+	UniqueRecipients      int                `json:"unique_recipients"`
+	Revenue               float64            `json:"revenue"`
+}
+
+func (s *CampaignsService) List(ctx context.Context, r *CampaignListRequest) (*CampaignListResponse, error) {
+	if r == nil {
+		return nil, errors.New("request must not be nil")
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, campaignsListPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res CampaignListResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *CampaignsService) Details(ctx context.Context, campaignID string) (*CampaignDetails, error) {
+	if campaignID == "" {
+		return nil, errors.New("campaign ID must not be empty")
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, campaignsDetailsPath, url.Values{"campaign_id": {campaignID}})
+	if err != nil {
+		return nil, err
+	}
+
+	var res CampaignDetails
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *CampaignsService) DataSeries(ctx context.Context, r *CampaignDataSeriesRequest) (*CampaignDataSeriesResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, campaignsDataSeriesPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res CampaignDataSeriesResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *CampaignsService) Campaigns(r *CampaignListRequest) *Iterator[*CampaignSummary] {
+	page := CampaignListRequest{}
+	if r != nil {
+		page = *r
+	}
+
+	return newIterator(func(ctx context.Context) ([]*CampaignSummary, bool, error) {
+		res, err := s.List(ctx, &page)
+		if err != nil {
+			return nil, false, err
+		}
+		page.Page++
+
+		return res.Campaigns, len(res.Campaigns) == campaignsListPageSize, nil
+	})
+}
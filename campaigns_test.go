@@ -0,0 +1,77 @@
+package braze_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCampaignsServiceCampaignsIterator(t *testing.T) {
+	srv, client := createTestServer(t, "/campaigns/list", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("include_archived"))
+		assert.Equal(t, "desc", r.URL.Query().Get("sort_direction"))
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		n := 100
+		if page == 1 {
+			n = 3
+		}
+
+		campaigns := make([]string, n)
+		for i := range campaigns {
+			campaigns[i] = fmt.Sprintf(`{"id":"c%d-%d","name":"n","last_edited":"2023-01-01T00:00:00Z"}`, page, i)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"message":"success","campaigns":[%s]}`, strings.Join(campaigns, ","))
+	})
+	defer srv.Close()
+
+	it := client.Campaigns().Campaigns(&braze.CampaignListRequest{
+		IncludeArchived: true,
+		SortDirection:   &braze.SortDirectionDesc,
+	})
+
+	var count int
+	var last string
+	for it.Next(context.Background()) {
+		count++
+		last = it.Value().ID
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, 103, count)
+	assert.Equal(t, "c1-2", last)
+}
+
+func TestCampaignsServiceDataSeries(t *testing.T) {
+	srv, client := createTestServer(t, "/campaigns/data_series", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "c1", r.URL.Query().Get("campaign_id"))
+		assert.Equal(t, "14", r.URL.Query().Get("length"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","data":[{"time":"2023-01-01","messages":{"ios_push":[{"variation_name":"A","sent":10,"direct_opens":3}],"email":[{"variation_name":"B","sent":20,"unique_opens":7,"revenue":2.5}],"content_cards":[{"total_impressions":11}]},"conversions":4,"unique_recipients":30}]}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Campaigns().DataSeries(context.Background(), &braze.CampaignDataSeriesRequest{
+		CampaignID: "c1",
+		Length:     14,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 1)
+
+	d := resp.Data[0]
+	assert.Equal(t, 4, d.Conversions)
+	assert.Equal(t, 3, d.Messages.IOSPush[0].DirectOpens)
+	assert.Equal(t, "B", d.Messages.Email[0].VariationName)
+	assert.Equal(t, 7, d.Messages.Email[0].UniqueOpens)
+	assert.Equal(t, 2.5, d.Messages.Email[0].Revenue)
+	assert.Equal(t, 11, d.Messages.ContentCards[0].TotalImpressions)
+}
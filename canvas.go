@@ -0,0 +1,322 @@
+package braze
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	canvasListPath        = "/canvas/list"
+	canvasDetailsPath     = "/canvas/details"
+	canvasDataSeriesPath  = "/canvas/data_series"
+	canvasDataSummaryPath = "/canvas/data_summary"
+
+	canvasListPageSize = 100
+)
+
+type CanvasEndpoint interface {
+	List(ctx context.Context, r *CanvasListRequest) (*CanvasListResponse, error)
+	Details(ctx context.Context, canvasID string) (*CanvasDetails, error)
+	DataSeries(ctx context.Context, r *CanvasAnalyticsRequest) (*CanvasDataSeriesResponse, error)
+	DataSummary(ctx context.Context, r *CanvasAnalyticsRequest) (*CanvasDataSummaryResponse, error)
+
+	// Canvases returns an iterator over all canvases matching the request,
+	// starting at its page.
+	Canvases(r *CanvasListRequest) *Iterator[*CanvasSummary]
+}
+
+var _ CanvasEndpoint = (*CanvasService)(nil)
+
+type CanvasService struct {
+	client *Client
+}
+
+// https://www.braze.com/docs/api/endpoints/export/canvas/get_canvases/
+type CanvasListRequest struct {
+	// Page of canvases to return, starting at 0. Each page has up to 100
+	// canvases.
+	Page            int
+	IncludeArchived bool
+	SortDirection   *SortDirection
+
+	// Only return canvases edited after this time.
+	LastEditedAfter *time.Time
+}
+
+func (r *CanvasListRequest) values() url.Values {
+	v := url.Values{}
+	if r.Page != 0 {
+		v.Set("page", strconv.Itoa(r.Page))
+	}
+	if r.IncludeArchived {
+		v.Set("include_archived", "true")
+	}
+	if r.SortDirection != nil {
+		v.Set("sort_direction", string(*r.SortDirection))
+	}
+	if r.LastEditedAfter != nil {
+		v.Set("last_edit.time[gt]", r.LastEditedAfter.Format(time.RFC3339))
+	}
+	return v
+}
+
+type CanvasListResponse struct {
+	Message  string           `json:"message,omitempty"`
+	Canvases []*CanvasSummary `json:"canvases,omitempty"`
+}
+
+type CanvasSummary struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Tags       []string  `json:"tags,omitempty"`
+	LastEdited time.Time `json:"last_edited"`
+}
+
+// https://www.braze.com/docs/api/endpoints/export/canvas/get_canvas_details/
+type CanvasDetails struct {
+	Message      string           `json:"message,omitempty"`
+	Name         string           `json:"name"`
+	Description  string           `json:"description,omitempty"`
+	CreatedAt    time.Time        `json:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+	Archived     bool             `json:"archived"`
+	Draft        bool             `json:"draft"`
+	ScheduleType string           `json:"schedule_type"`
+	FirstEntry   *time.Time       `json:"first_entry,omitempty"`
+	LastEntry    *time.Time       `json:"last_entry,omitempty"`
+	Channels     []string         `json:"channels,omitempty"`
+	Tags         []string         `json:"tags,omitempty"`
+	Variants     []*CanvasVariant `json:"variants,omitempty"`
+	Steps        []*CanvasStep    `json:"steps,omitempty"`
+}
+
+type CanvasVariant struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	FirstStepIDs []string `json:"first_step_ids,omitempty"`
+}
+
+type CanvasStep struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	NextStepIDs []string `json:"next_step_ids,omitempty"`
+	Channels    []string `json:"channels,omitempty"`
+
+	// Messages keyed by message variation ID.
+	Messages map[string]*CampaignMessage `json:"messages,omitempty"`
+}
+
+// https://www.braze.com/docs/api/endpoints/export/canvas/get_canvas_analytics/
+// https://www.braze.com/docs/api/endpoints/export/canvas/get_canvas_analytics_summary/
+//
+// The date range is given either by StartingAt or by Length.
+type CanvasAnalyticsRequest struct {
+	CanvasID string
+	EndingAt time.Time
+
+	StartingAt *time.Time
+	// Max number of days before EndingAt to include. Must be between 1 and 14
+	// (inclusive).
+	Length int
+
+	IncludeVariantBreakdown bool
+	IncludeStepBreakdown    bool
+	IncludeDeletedStepData  bool
+}
+
+func (r *CanvasAnalyticsRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.CanvasID == "" {
+		return errors.New("canvas ID must not be empty")
+	}
+
+	if r.EndingAt.IsZero() {
+		return errors.New("ending at must be set")
+	}
+
+	if (r.StartingAt == nil) == (r.Length == 0) {
+		return errors.New("exactly one of starting at or length must be set")
+	}
+
+	if r.StartingAt == nil && (r.Length < 1 || r.Length > 14) {
+		return errors.New("length must be between 1 and 14")
+	}
+
+	return nil
+}
+
+func (r *CanvasAnalyticsRequest) values() url.Values {
+	v := url.Values{}
+	v.Set("canvas_id", r.CanvasID)
+	v.Set("ending_at", r.EndingAt.Format(time.RFC3339))
+	if r.StartingAt != nil {
+		v.Set("starting_at", r.StartingAt.Format(time.RFC3339))
+	}
+	if r.Length != 0 {
+		v.Set("length", strconv.Itoa(r.Length))
+	}
+	if r.IncludeVariantBreakdown {
+		v.Set("include_variant_breakdown", "true")
+	}
+	if r.IncludeStepBreakdown {
+		v.Set("include_step_breakdown", "true")
+	}
+	if r.IncludeDeletedStepData {
+		v.Set("include_deleted_step_data", "true")
+	}
+	return v
+}
+
+type CanvasDataSeriesResponse struct {
+	Message string            `json:"message,omitempty"`
+	Data    *CanvasDataSeries `json:"data,omitempty"`
+}
+
+type CanvasDataSeries struct {
+	Name  string                   `json:"name"`
+	Stats []*CanvasDataSeriesEntry `json:"stats,omitempty"`
+}
+
+type CanvasDataSeriesEntry struct {
+	// Date of the entry in yyyy-MM-dd format.
+	Time string `json:"time"`
+	CanvasStatistics
+}
+
+type CanvasDataSummaryResponse struct {
+	Message string             `json:"message,omitempty"`
+	Data    *CanvasDataSummary `json:"data,omitempty"`
+}
+
+type CanvasDataSummary struct {
+	Name string `json:"name"`
+	CanvasStatistics
+}
+
+// CanvasStatistics holds the totals of a canvas together with the variant and
+// step breakdowns when requested.
+type CanvasStatistics struct {
+	TotalStats *CanvasTotalStatistics `json:"total_stats,omitempty"`
+
+	// Keyed by variant API ID.
+	VariantStats map[string]*CanvasVariantStatistics `json:"variant_stats,omitempty"`
+	// Keyed by step API ID.
+	StepStats map[string]*CanvasStepStatistics `json:"step_stats,omitempty"`
+}
+
+type CanvasTotalStatistics struct {
+	Revenue                float64 `json:"revenue"`
+	Conversions            int     `json:"conversions"`
+	ConversionsByEntryTime int     `json:"conversions_by_entry_time"`
+	Entries                int     `json:"entries"`
+}
+
+type CanvasVariantStatistics struct {
+	Name string `json:"name"`
+	CanvasTotalStatistics
+}
+
+type CanvasStepStatistics struct {
+	Name                   string             `json:"name"`
+	Revenue                float64            `json:"revenue"`
+	Conversions            int                `json:"conversions"`
+	ConversionsByEntryTime int                `json:"conversions_by_entry_time"`
+	Messages               *ChannelStatistics `json:"messages,omitempty"`
+}
+
+func (s *CanvasService) List(ctx context.Context, r *CanvasListRequest) (*CanvasListResponse, error) {
+	if r == nil {
+		return nil, errors.New("request must not be nil")
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, canvasListPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res CanvasListResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *CanvasService) Details(ctx context.Context, canvasID string) (*CanvasDetails, error) {
+	if canvasID == "" {
+		return nil, errors.New("canvas ID must not be empty")
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, canvasDetailsPath, url.Values{"canvas_id": {canvasID}})
+	if err != nil {
+		return nil, err
+	}
+
+	var res CanvasDetails
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *CanvasService) DataSeries(ctx context.Context, r *CanvasAnalyticsRequest) (*CanvasDataSeriesResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, canvasDataSeriesPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res CanvasDataSeriesResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *CanvasService) DataSummary(ctx context.Context, r *CanvasAnalyticsRequest) (*CanvasDataSummaryResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, canvasDataSummaryPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res CanvasDataSummaryResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *CanvasService) Canvases(r *CanvasListRequest) *Iterator[*CanvasSummary] {
+	page := CanvasListRequest{}
+	if r != nil {
+		page = *r
+	}
+
+	return newIterator(func(ctx context.Context) ([]*CanvasSummary, bool, error) {
+		res, err := s.List(ctx, &page)
+		if err != nil {
+			return nil, false, err
+		}
+		page.Page++
+
+		return res.Canvases, len(res.Canvases) == canvasListPageSize, nil
+	})
+}
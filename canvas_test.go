@@ -0,0 +1,58 @@
+package braze_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanvasServiceDataSeries(t *testing.T) {
+	srv, client := createTestServer(t, "/canvas/data_series", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "cv1", q.Get("canvas_id"))
+		assert.Equal(t, "2023-01-31T00:00:00Z", q.Get("ending_at"))
+		assert.Equal(t, "2023-01-01T00:00:00Z", q.Get("starting_at"))
+		assert.Equal(t, "true", q.Get("include_step_breakdown"))
+		assert.Empty(t, q.Get("length"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","data":{"name":"Onboarding","stats":[{"time":"2023-01-01","total_stats":{"revenue":1.5,"conversions":2,"entries":10},"step_stats":{"s1":{"name":"Welcome","conversions":1,"messages":{"email":[{"sent":10,"opens":5}]}}}}]}}`))
+	})
+	defer srv.Close()
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	resp, err := client.Canvas().DataSeries(context.Background(), &braze.CanvasAnalyticsRequest{
+		CanvasID:             "cv1",
+		EndingAt:             time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC),
+		StartingAt:           &start,
+		IncludeStepBreakdown: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Onboarding", resp.Data.Name)
+	require.Len(t, resp.Data.Stats, 1)
+
+	stats := resp.Data.Stats[0]
+	assert.Equal(t, "2023-01-01", stats.Time)
+	assert.Equal(t, 10, stats.TotalStats.Entries)
+	assert.Equal(t, "Welcome", stats.StepStats["s1"].Name)
+	assert.Equal(t, 5, stats.StepStats["s1"].Messages.Email[0].Opens)
+}
+
+func TestCanvasServiceDataSummaryInvalidRange(t *testing.T) {
+	srv, client := createTestServer(t, "/canvas/data_summary", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.Canvas().DataSummary(context.Background(), &braze.CanvasAnalyticsRequest{
+		CanvasID: "cv1",
+		EndingAt: time.Now(),
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,435 @@
+package braze
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	catalogsPath            = "/catalogs"
+	catalogPath             = "/catalogs/%s"
+	catalogItemsPath        = "/catalogs/%s/items"
+	catalogItemPath         = "/catalogs/%s/items/%s"
+	catalogItemsMaxPerBatch = 50
+)
+
+var (
+	_ CatalogsEndpoint = (*CatalogsService)(nil)
+
+	CatalogFieldTypeString  CatalogFieldType = "string"
+	CatalogFieldTypeNumber  CatalogFieldType = "number"
+	CatalogFieldTypeBoolean CatalogFieldType = "boolean"
+	CatalogFieldTypeTime    CatalogFieldType = "time"
+)
+
+// CatalogsEndpoint works with untyped catalog items. Use NewCatalogItems for a
+// typed view on the items of a single catalog.
+type CatalogsEndpoint interface {
+	ListCatalogs(ctx context.Context) (*CatalogsResponse, error)
+	CreateCatalogs(ctx context.Context, r *CatalogCreateRequest) (*CatalogsResponse, error)
+	DeleteCatalog(ctx context.Context, catalog string) (*Response, error)
+
+	// Synchronous operations on a single item. Items are marshalled as is and
+	// must not contain an id field, the item ID is passed separately.
+	GetItem(ctx context.Context, catalog, id string) (*CatalogItemsResponse, error)
+	CreateItem(ctx context.Context, catalog, id string, item any) (*CatalogItemsResponse, error)
+	EditItem(ctx context.Context, catalog, id string, item any) (*CatalogItemsResponse, error)
+	UpdateItem(ctx context.Context, catalog, id string, item any) (*CatalogItemsResponse, error)
+	DeleteItem(ctx context.Context, catalog, id string) (*Response, error)
+
+	// ListItems returns a page of items starting at cursor. An empty cursor
+	// starts at the first page.
+	ListItems(ctx context.Context, catalog, cursor string) (*CatalogItemsResponse, error)
+
+	// Asynchronous bulk operations on up to 50 items. Every item must contain
+	// an id field.
+	CreateItems(ctx context.Context, catalog string, items []any) (*Response, error)
+	EditItems(ctx context.Context, catalog string, items []any) (*Response, error)
+	UpdateItems(ctx context.Context, catalog string, items []any) (*Response, error)
+	DeleteItems(ctx context.Context, catalog string, ids []string) (*Response, error)
+}
+
+type CatalogFieldType string
+
+type CatalogsService struct {
+	client *Client
+}
+
+// https://www.braze.com/docs/api/endpoints/catalogs/catalog_management/synchronous/post_create_catalog/
+type CatalogCreateRequest struct {
+	Catalogs []*Catalog `json:"catalogs"`
+}
+
+type Catalog struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Fields      []*CatalogField `json:"fields"`
+	NumItems    int             `json:"num_items,omitempty"`
+	UpdatedAt   *time.Time      `json:"updated_at,omitempty"`
+}
+
+// The first field of a catalog must be named "id" and be of type string.
+type CatalogField struct {
+	Name string           `json:"name"`
+	Type CatalogFieldType `json:"type"`
+}
+
+type CatalogsResponse struct {
+	Message  string     `json:"message,omitempty"`
+	Catalogs []*Catalog `json:"catalogs,omitempty"`
+}
+
+type CatalogItemsResponse struct {
+	Message string            `json:"message,omitempty"`
+	Items   []json.RawMessage `json:"items,omitempty"`
+
+	// Cursor of the next page when listing items, empty on the last page.
+	NextCursor string `json:"-"`
+}
+
+func (s *CatalogsService) ListCatalogs(ctx context.Context) (*CatalogsResponse, error) {
+	req, err := s.client.http.newRequest(http.MethodGet, catalogsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res CatalogsResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *CatalogsService) CreateCatalogs(ctx context.Context, r *CatalogCreateRequest) (*CatalogsResponse, error) {
+	if r == nil || len(r.Catalogs) == 0 {
+		return nil, errors.New("catalogs must not be empty")
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, catalogsPath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res CatalogsResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *CatalogsService) DeleteCatalog(ctx context.Context, catalog string) (*Response, error) {
+	if catalog == "" {
+		return nil, errors.New("catalog name must not be empty")
+	}
+
+	req, err := s.client.http.newRequest(http.MethodDelete, fmt.Sprintf(catalogPath, url.PathEscape(catalog)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *CatalogsService) GetItem(ctx context.Context, catalog, id string) (*CatalogItemsResponse, error) {
+	return s.itemRequest(ctx, http.MethodGet, catalog, id, nil)
+}
+
+func (s *CatalogsService) CreateItem(ctx context.Context, catalog, id string, item any) (*CatalogItemsResponse, error) {
+	return s.itemRequest(ctx, http.MethodPost, catalog, id, item)
+}
+
+func (s *CatalogsService) EditItem(ctx context.Context, catalog, id string, item any) (*CatalogItemsResponse, error) {
+	return s.itemRequest(ctx, http.MethodPatch, catalog, id, item)
+}
+
+func (s *CatalogsService) UpdateItem(ctx context.Context, catalog, id string, item any) (*CatalogItemsResponse, error) {
+	return s.itemRequest(ctx, http.MethodPut, catalog, id, item)
+}
+
+func (s *CatalogsService) DeleteItem(ctx context.Context, catalog, id string) (*Response, error) {
+	res, err := s.itemRequest(ctx, http.MethodDelete, catalog, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Response{Message: res.Message}, nil
+}
+
+func (s *CatalogsService) itemRequest(ctx context.Context, method, catalog, id string, item any) (*CatalogItemsResponse, error) {
+	if catalog == "" {
+		return nil, errors.New("catalog name must not be empty")
+	}
+
+	if id == "" {
+		return nil, errors.New("item ID must not be empty")
+	}
+
+	var body any
+	if item != nil {
+		body = &struct {
+			Items []any `json:"items"`
+		}{
+			Items: []any{item},
+		}
+	}
+
+	req, err := s.client.http.newRequest(method, fmt.Sprintf(catalogItemPath, url.PathEscape(catalog), url.PathEscape(id)), body)
+	if err != nil {
+		return nil, err
+	}
+
+	var res CatalogItemsResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *CatalogsService) ListItems(ctx context.Context, catalog, cursor string) (*CatalogItemsResponse, error) {
+	if catalog == "" {
+		return nil, errors.New("catalog name must not be empty")
+	}
+
+	query := url.Values{}
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, fmt.Sprintf(catalogItemsPath, url.PathEscape(catalog)), query)
+	if err != nil {
+		return nil, err
+	}
+
+	var res CatalogItemsResponse
+	resp, err := s.client.http.doResponse(ctx, req, &res)
+	if err != nil {
+		return nil, err
+	}
+	res.NextCursor = nextCursor(resp.Header)
+
+	return &res, nil
+}
+
+func (s *CatalogsService) CreateItems(ctx context.Context, catalog string, items []any) (*Response, error) {
+	return s.itemsRequest(ctx, http.MethodPost, catalog, items)
+}
+
+func (s *CatalogsService) EditItems(ctx context.Context, catalog string, items []any) (*Response, error) {
+	return s.itemsRequest(ctx, http.MethodPatch, catalog, items)
+}
+
+func (s *CatalogsService) UpdateItems(ctx context.Context, catalog string, items []any) (*Response, error) {
+	return s.itemsRequest(ctx, http.MethodPut, catalog, items)
+}
+
+func (s *CatalogsService) DeleteItems(ctx context.Context, catalog string, ids []string) (*Response, error) {
+	items := make([]any, len(ids))
+	for i, id := range ids {
+		items[i] = map[string]string{"id": id}
+	}
+	return s.itemsRequest(ctx, http.MethodDelete, catalog, items)
+}
+
+func (s *CatalogsService) itemsRequest(ctx context.Context, method, catalog string, items []any) (*Response, error) {
+	if catalog == "" {
+		return nil, errors.New("catalog name must not be empty")
+	}
+
+	if len(items) == 0 {
+		return nil, errors.New("items must not be empty")
+	}
+
+	if len(items) > catalogItemsMaxPerBatch {
+		return nil, fmt.Errorf("at most %d items can be sent in a single request", catalogItemsMaxPerBatch)
+	}
+
+	body := struct {
+		Items []any `json:"items"`
+	}{
+		Items: items,
+	}
+
+	req, err := s.client.http.newRequest(method, fmt.Sprintf(catalogItemsPath, url.PathEscape(catalog)), &body)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// nextCursor extracts the cursor of the rel="next" link from the Link header.
+func nextCursor(h http.Header) string {
+	for _, link := range strings.Split(h.Get("Link"), ",") {
+		target, params, ok := strings.Cut(link, ";")
+		if !ok || !strings.Contains(params, `rel="next"`) {
+			continue
+		}
+
+		u, err := url.Parse(strings.Trim(strings.TrimSpace(target), "<>"))
+		if err != nil {
+			return ""
+		}
+		return u.Query().Get("cursor")
+	}
+	return ""
+}
+
+// CatalogItem is a catalog item with fields of type T. T must marshal to a
+// JSON object, the item ID is added to it as the id field.
+type CatalogItem[T any] struct {
+	ID     string
+	Fields T
+}
+
+func (i CatalogItem[T]) MarshalJSON() ([]byte, error) {
+	d, err := json.Marshal(i.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(d, &m); err != nil {
+		return nil, err
+	}
+
+	id, err := json.Marshal(i.ID)
+	if err != nil {
+		return nil, err
+	}
+	m["id"] = id
+
+	return json.Marshal(m)
+}
+
+func (i *CatalogItem[T]) UnmarshalJSON(b []byte) error {
+	var id struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(b, &id); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(b, &i.Fields); err != nil {
+		return err
+	}
+	i.ID = id.ID
+
+	return nil
+}
+
+// CatalogItems is a typed view on the items of a single catalog.
+type CatalogItems[T any] struct {
+	endpoint CatalogsEndpoint
+	catalog  string
+}
+
+// NewCatalogItems returns a typed view on the items of the named catalog.
+func NewCatalogItems[T any](e CatalogsEndpoint, catalog string) *CatalogItems[T] {
+	return &CatalogItems[T]{endpoint: e, catalog: catalog}
+}
+
+func (c *CatalogItems[T]) Get(ctx context.Context, id string) (*CatalogItem[T], error) {
+	res, err := c.endpoint.GetItem(ctx, c.catalog, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Items) == 0 {
+		return nil, fmt.Errorf("catalog item %q not found", id)
+	}
+
+	var item CatalogItem[T]
+	if err := json.Unmarshal(res.Items[0], &item); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (c *CatalogItems[T]) Create(ctx context.Context, item *CatalogItem[T]) error {
+	_, err := c.endpoint.CreateItem(ctx, c.catalog, item.ID, item.Fields)
+	return err
+}
+
+func (c *CatalogItems[T]) Edit(ctx context.Context, item *CatalogItem[T]) error {
+	_, err := c.endpoint.EditItem(ctx, c.catalog, item.ID, item.Fields)
+	return err
+}
+
+func (c *CatalogItems[T]) Update(ctx context.Context, item *CatalogItem[T]) error {
+	_, err := c.endpoint.UpdateItem(ctx, c.catalog, item.ID, item.Fields)
+	return err
+}
+
+func (c *CatalogItems[T]) Delete(ctx context.Context, id string) error {
+	_, err := c.endpoint.DeleteItem(ctx, c.catalog, id)
+	return err
+}
+
+func (c *CatalogItems[T]) CreateAsync(ctx context.Context, items []*CatalogItem[T]) error {
+	_, err := c.endpoint.CreateItems(ctx, c.catalog, catalogItemsAny(items))
+	return err
+}
+
+func (c *CatalogItems[T]) EditAsync(ctx context.Context, items []*CatalogItem[T]) error {
+	_, err := c.endpoint.EditItems(ctx, c.catalog, catalogItemsAny(items))
+	return err
+}
+
+func (c *CatalogItems[T]) UpdateAsync(ctx context.Context, items []*CatalogItem[T]) error {
+	_, err := c.endpoint.UpdateItems(ctx, c.catalog, catalogItemsAny(items))
+	return err
+}
+
+func (c *CatalogItems[T]) DeleteAsync(ctx context.Context, ids []string) error {
+	_, err := c.endpoint.DeleteItems(ctx, c.catalog, ids)
+	return err
+}
+
+// List returns an iterator over all items of the catalog.
+func (c *CatalogItems[T]) List() *Iterator[*CatalogItem[T]] {
+	var cursor string
+	return newIterator(func(ctx context.Context) ([]*CatalogItem[T], bool, error) {
+		res, err := c.endpoint.ListItems(ctx, c.catalog, cursor)
+		if err != nil {
+			return nil, false, err
+		}
+		cursor = res.NextCursor
+
+		items := make([]*CatalogItem[T], len(res.Items))
+		for i, raw := range res.Items {
+			items[i] = &CatalogItem[T]{}
+			if err := json.Unmarshal(raw, items[i]); err != nil {
+				return nil, false, err
+			}
+		}
+
+		return items, cursor != "", nil
+	})
+}
+
+func catalogItemsAny[T any](items []*CatalogItem[T]) []any {
+	s := make([]any, len(items))
+	for i, item := range items {
+		s[i] = item
+	}
+	return s
+}
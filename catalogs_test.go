@@ -0,0 +1,219 @@
+package braze_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recipe struct {
+	Name     string  `json:"name"`
+	Calories float64 `json:"calories,omitempty"`
+}
+
+func TestCatalogsServiceCreateItemsAsync(t *testing.T) {
+	srv, client := createTestServer(t, "/catalogs/recipes/items", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"items":[{"id":"r1","name":"Keto bread","calories":120},{"id":"r2","name":"Omelette"}]}`, string(b))
+
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"message":"success"}`))
+	})
+	defer srv.Close()
+
+	items := braze.NewCatalogItems[recipe](client.Catalogs(), "recipes")
+	err := items.CreateAsync(context.Background(), []*braze.CatalogItem[recipe]{
+		{ID: "r1", Fields: recipe{Name: "Keto bread", Calories: 120}},
+		{ID: "r2", Fields: recipe{Name: "Omelette"}},
+	})
+	require.NoError(t, err)
+}
+
+func TestCatalogsServiceEditItem(t *testing.T) {
+	srv, client := createTestServer(t, "/catalogs/recipes/items/r1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"items":[{"name":"Keto bread"}]}`, string(b))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success"}`))
+	})
+	defer srv.Close()
+
+	items := braze.NewCatalogItems[recipe](client.Catalogs(), "recipes")
+	err := items.Edit(context.Background(), &braze.CatalogItem[recipe]{ID: "r1", Fields: recipe{Name: "Keto bread"}})
+	require.NoError(t, err)
+}
+
+func TestCatalogsServiceListItems(t *testing.T) {
+	var srvURL string
+	srv, client := createTestServer(t, "/catalogs/recipes/items", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Header().Set("Link", `<`+srvURL+`/catalogs/recipes/items?cursor=c2>; rel="next"`)
+			w.Write([]byte(`{"items":[{"id":"r1","name":"Keto bread"}],"message":"success"}`))
+		case "c2":
+			w.Header().Set("Link", `<`+srvURL+`/catalogs/recipes/items?cursor=c1>; rel="prev"`)
+			w.Write([]byte(`{"items":[{"id":"r2","name":"Omelette"}],"message":"success"}`))
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	})
+	defer srv.Close()
+	srvURL = srv.URL
+
+	it := braze.NewCatalogItems[recipe](client.Catalogs(), "recipes").List()
+
+	var got []*braze.CatalogItem[recipe]
+	for it.Next(context.Background()) {
+		got = append(got, it.Value())
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []*braze.CatalogItem[recipe]{
+		{ID: "r1", Fields: recipe{Name: "Keto bread"}},
+		{ID: "r2", Fields: recipe{Name: "Omelette"}},
+	}, got)
+}
+
+func TestCatalogsServiceDeleteItemsTooMany(t *testing.T) {
+	srv, client := createTestServer(t, "/catalogs/recipes/items", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.Catalogs().DeleteItems(context.Background(), "recipes", make([]string, 51))
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestCatalogItemsSync(t *testing.T) {
+	const (
+		interval     = 20 * time.Millisecond
+		listInterval = 40 * time.Millisecond
+		backoff      = 60 * time.Millisecond
+	)
+
+	var (
+		created, updated, deleted []string
+		listLimited, postLimited  bool
+		times                     []time.Time
+		statuses                  []int
+		methods                   []string
+		srvURL                    string
+	)
+
+	srv, client := createTestServer(t, "/catalogs/recipes/items", func(w http.ResponseWriter, r *http.Request) {
+		times = append(times, time.Now())
+		methods = append(methods, r.Method)
+		status := http.StatusAccepted
+		defer func() { statuses = append(statuses, status) }()
+
+		var body struct {
+			Items []struct {
+				ID string `json:"id"`
+			} `json:"items"`
+		}
+		if r.Method != http.MethodGet {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.LessOrEqual(t, len(body.Items), 50)
+		}
+
+		var ids []string
+		for _, item := range body.Items {
+			ids = append(ids, item.ID)
+		}
+
+		// Rate limit the second page and the first bulk request to exercise
+		// retries.
+		switch r.Method {
+		case http.MethodGet:
+			status = http.StatusOK
+			if r.URL.Query().Get("cursor") == "" {
+				w.Header().Set("Link", `<`+srvURL+`/catalogs/recipes/items?cursor=c2>; rel="next"`)
+				w.Write([]byte(`{"items":[{"id":"keep","name":"Keep"},{"id":"change","name":"Old"}]}`))
+				return
+			}
+			if !listLimited {
+				listLimited = true
+				status = http.StatusTooManyRequests
+				w.WriteHeader(status)
+				w.Write([]byte(`{"message":"rate limited"}`))
+				return
+			}
+			w.Write([]byte(`{"items":[{"id":"stale","name":"Stale"}]}`))
+			return
+		case http.MethodPost:
+			if !postLimited {
+				postLimited = true
+				status = http.StatusTooManyRequests
+				w.WriteHeader(status)
+				w.Write([]byte(`{"message":"rate limited"}`))
+				return
+			}
+			created = append(created, ids...)
+		case http.MethodPut:
+			updated = append(updated, ids...)
+		case http.MethodDelete:
+			deleted = append(deleted, ids...)
+		}
+
+		w.WriteHeader(status)
+		w.Write([]byte(`{"message":"success"}`))
+	})
+	defer srv.Close()
+	srvURL = srv.URL
+
+	desired := []*braze.CatalogItem[recipe]{
+		{ID: "keep", Fields: recipe{Name: "Keep"}},
+		{ID: "change", Fields: recipe{Name: "New"}},
+	}
+	for i := 0; i < 60; i++ {
+		desired = append(desired, &braze.CatalogItem[recipe]{ID: fmt.Sprintf("new%d", i), Fields: recipe{Name: "New"}})
+	}
+
+	summary, err := braze.NewCatalogItems[recipe](client.Catalogs(), "recipes").Sync(context.Background(), desired, &braze.CatalogSyncOptions{
+		Interval:     interval,
+		ListInterval: listInterval,
+		Backoff:      backoff,
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, created, 60)
+	assert.Equal(t, []string{"change"}, updated)
+	assert.Equal(t, []string{"stale"}, deleted)
+	assert.Equal(t, created, summary.Created)
+	assert.Equal(t, updated, summary.Updated)
+	assert.Equal(t, deleted, summary.Deleted)
+	assert.Equal(t, 1, summary.Unchanged)
+	assert.Equal(t, "60 created, 1 updated, 1 deleted, 1 unchanged", summary.String())
+
+	// Two list pages, two bulk creates, one update and one delete, plus a
+	// retry of each rate limited request.
+	require.Len(t, times, 8)
+	for i := 1; i < len(times); i++ {
+		var want time.Duration
+		switch {
+		case statuses[i-1] == http.StatusTooManyRequests:
+			want = backoff
+		case methods[i] == http.MethodGet:
+			want = listInterval
+		case methods[i-1] != http.MethodGet:
+			want = interval
+		}
+		assert.GreaterOrEqual(t, times[i].Sub(times[i-1]), want, "delay before request %d", i)
+	}
+}
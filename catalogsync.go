@@ -0,0 +1,238 @@
+package braze
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Braze allows 100 requests per minute to the asynchronous catalog item
+// endpoints and 50 requests per minute to the endpoint listing catalog items.
+const (
+	catalogSyncDefaultInterval     = 600 * time.Millisecond
+	catalogSyncDefaultListInterval = 1200 * time.Millisecond
+	catalogSyncDefaultMaxRetries   = 3
+	catalogSyncDefaultBackoff      = 15 * time.Second
+)
+
+// CatalogSyncOptions configure CatalogItems.Sync. Zero fields, or a nil value,
+// use the defaults.
+type CatalogSyncOptions struct {
+	// Delay between consecutive bulk requests. Defaults to 600ms, a negative
+	// value disables pacing.
+	Interval time.Duration
+
+	// Delay between consecutive requests listing the current items. Defaults
+	// to 1.2s, a negative value disables pacing.
+	ListInterval time.Duration
+
+	// Number of times a request is retried after being rate limited. Defaults
+	// to 3, a negative value disables retries.
+	MaxRetries int
+
+	// Delay before the first retry of a rate limited request, doubled on every
+	// following retry. Defaults to 15s.
+	Backoff time.Duration
+
+	// Compute the changes without applying them.
+	DryRun bool
+}
+
+// CatalogSyncSummary lists the IDs of the items changed by a sync.
+type CatalogSyncSummary struct {
+	Created   []string
+	Updated   []string
+	Deleted   []string
+	Unchanged int
+}
+
+func (s *CatalogSyncSummary) String() string {
+	return fmt.Sprintf("%d created, %d updated, %d deleted, %d unchanged", len(s.Created), len(s.Updated), len(s.Deleted), s.Unchanged)
+}
+
+// Sync makes the catalog contain exactly the desired items. It fetches the
+// current items, creates the missing ones, updates the changed ones and deletes
+// the ones not present in desired using asynchronous bulk requests of up to 50
+// items. Items are compared by their JSON representation. Every request,
+// including the ones listing the current items, is paced and retried when rate
+// limited as configured by opts.
+//
+// The returned summary is populated even when an error occurs part way and
+// then only lists the changes that were applied.
+func (c *CatalogItems[T]) Sync(ctx context.Context, desired []*CatalogItem[T], opts *CatalogSyncOptions) (*CatalogSyncSummary, error) {
+	var o CatalogSyncOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Interval == 0 {
+		o.Interval = catalogSyncDefaultInterval
+	}
+	if o.ListInterval == 0 {
+		o.ListInterval = catalogSyncDefaultListInterval
+	}
+	if o.MaxRetries == 0 {
+		o.MaxRetries = catalogSyncDefaultMaxRetries
+	}
+	if o.Backoff == 0 {
+		o.Backoff = catalogSyncDefaultBackoff
+	}
+
+	// Listing and bulk requests are rate limited separately and paced
+	// independently.
+	paced := func(interval time.Duration) func(func() error) error {
+		first := true
+		return func(f func() error) error {
+			if !first {
+				if err := sleep(ctx, interval); err != nil {
+					return err
+				}
+			}
+			first = false
+			return retryRateLimited(ctx, &o, f)
+		}
+	}
+	list, call := paced(o.ListInterval), paced(o.Interval)
+
+	current := map[string][]byte{}
+	var currentIDs []string
+	var cursor string
+	for {
+		var res *CatalogItemsResponse
+		err := list(func() (err error) {
+			res, err = c.endpoint.ListItems(ctx, c.catalog, cursor)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, raw := range res.Items {
+			var item CatalogItem[T]
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return nil, err
+			}
+			b, err := json.Marshal(item)
+			if err != nil {
+				return nil, err
+			}
+			current[item.ID] = b
+			currentIDs = append(currentIDs, item.ID)
+		}
+
+		if res.NextCursor == "" {
+			break
+		}
+		cursor = res.NextCursor
+	}
+
+	var creates, updates []*CatalogItem[T]
+	seen := make(map[string]bool, len(desired))
+	unchanged := 0
+	for _, item := range desired {
+		if item.ID == "" {
+			return nil, errors.New("item ID must not be empty")
+		}
+		if seen[item.ID] {
+			return nil, fmt.Errorf("duplicate item ID %q", item.ID)
+		}
+		seen[item.ID] = true
+
+		b, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+
+		cur, ok := current[item.ID]
+		switch {
+		case !ok:
+			creates = append(creates, item)
+		case !bytes.Equal(cur, b):
+			updates = append(updates, item)
+		default:
+			unchanged++
+		}
+	}
+
+	var deletes []string
+	for _, id := range currentIDs {
+		if !seen[id] {
+			deletes = append(deletes, id)
+		}
+	}
+
+	summary := &CatalogSyncSummary{Unchanged: unchanged}
+	if o.DryRun {
+		summary.Created = catalogItemIDs(creates)
+		summary.Updated = catalogItemIDs(updates)
+		summary.Deleted = deletes
+		return summary, nil
+	}
+
+	for _, batch := range chunk(creates, catalogItemsMaxPerBatch) {
+		if err := call(func() error { return c.CreateAsync(ctx, batch) }); err != nil {
+			return summary, err
+		}
+		summary.Created = append(summary.Created, catalogItemIDs(batch)...)
+	}
+
+	for _, batch := range chunk(updates, catalogItemsMaxPerBatch) {
+		if err := call(func() error { return c.UpdateAsync(ctx, batch) }); err != nil {
+			return summary, err
+		}
+		summary.Updated = append(summary.Updated, catalogItemIDs(batch)...)
+	}
+
+	for _, batch := range chunk(deletes, catalogItemsMaxPerBatch) {
+		if err := call(func() error { return c.DeleteAsync(ctx, batch) }); err != nil {
+			return summary, err
+		}
+		summary.Deleted = append(summary.Deleted, batch...)
+	}
+
+	return summary, nil
+}
+
+func retryRateLimited(ctx context.Context, opts *CatalogSyncOptions, f func() error) error {
+	backoff := opts.Backoff
+	for attempt := 0; ; attempt++ {
+		err := f()
+
+		var e *ErrorResponse
+		if attempt >= opts.MaxRetries || !errors.As(err, &e) || e.ErrorCode != http.StatusTooManyRequests {
+			return err
+		}
+
+		if err := sleep(ctx, backoff); err != nil {
+			return err
+		}
+		backoff *= 2
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func catalogItemIDs[T any](items []*CatalogItem[T]) []string {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	return ids
+}
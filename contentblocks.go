@@ -0,0 +1,283 @@
+package braze
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	contentBlocksCreatePath = "/content_blocks/create"
+	contentBlocksUpdatePath = "/content_blocks/update"
+	contentBlocksListPath   = "/content_blocks/list"
+	contentBlocksInfoPath   = "/content_blocks/info"
+
+	contentBlocksListDefaultLimit = 100
+)
+
+var (
+	_ ContentBlocksEndpoint = (*ContentBlocksService)(nil)
+
+	ContentBlockStateActive ContentBlockState = "active"
+	ContentBlockStateDraft  ContentBlockState = "draft"
+)
+
+type ContentBlocksEndpoint interface {
+	Create(ctx context.Context, r *ContentBlockCreateRequest) (*ContentBlockResponse, error)
+	Update(ctx context.Context, r *ContentBlockUpdateRequest) (*ContentBlockResponse, error)
+	List(ctx context.Context, r *ContentBlockListRequest) (*ContentBlockListResponse, error)
+	Info(ctx context.Context, r *ContentBlockInfoRequest) (*ContentBlock, error)
+
+	// ContentBlocks returns an iterator over all content blocks matching the
+	// request, starting at its offset.
+	ContentBlocks(r *ContentBlockListRequest) *Iterator[*ContentBlockSummary]
+}
+
+type ContentBlockState string
+
+type ContentBlocksService struct {
+	client *Client
+}
+
+// https://www.braze.com/docs/api/endpoints/templates/content_blocks_templates/post_create_email_content_block/
+type ContentBlockCreateRequest struct {
+	Name        string             `json:"name"`
+	Description *string            `json:"description,omitempty"`
+	Content     string             `json:"content"`
+	State       *ContentBlockState `json:"state,omitempty"`
+	Tags        []string           `json:"tags,omitempty"`
+}
+
+func (r *ContentBlockCreateRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.Name == "" {
+		return errors.New("name must not be empty")
+	}
+
+	if r.Content == "" {
+		return errors.New("content must not be empty")
+	}
+
+	return nil
+}
+
+// https://www.braze.com/docs/api/endpoints/templates/content_blocks_templates/post_update_content_block/
+//
+// Only the set fields are updated.
+type ContentBlockUpdateRequest struct {
+	ContentBlockID string             `json:"content_block_id"`
+	Name           *string            `json:"name,omitempty"`
+	Description    *string            `json:"description,omitempty"`
+	Content        *string            `json:"content,omitempty"`
+	State          *ContentBlockState `json:"state,omitempty"`
+	Tags           []string           `json:"tags,omitempty"`
+}
+
+func (r *ContentBlockUpdateRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.ContentBlockID == "" {
+		return errors.New("content block ID must not be empty")
+	}
+
+	return nil
+}
+
+type ContentBlockResponse struct {
+	Message        string    `json:"message,omitempty"`
+	ContentBlockID string    `json:"content_block_id,omitempty"`
+	LiquidTag      string    `json:"liquid_tag,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// https://www.braze.com/docs/api/endpoints/templates/content_blocks_templates/get_list_email_content_blocks/
+type ContentBlockListRequest struct {
+	ModifiedAfter  *time.Time
+	ModifiedBefore *time.Time
+
+	// Max number of content blocks to return, up to 1000. Defaults to 100.
+	Limit  int
+	Offset int
+}
+
+func (r *ContentBlockListRequest) values() url.Values {
+	v := url.Values{}
+	if r.ModifiedAfter != nil {
+		v.Set("modified_after", r.ModifiedAfter.Format(time.RFC3339))
+	}
+	if r.ModifiedBefore != nil {
+		v.Set("modified_before", r.ModifiedBefore.Format(time.RFC3339))
+	}
+	if r.Limit != 0 {
+		v.Set("limit", strconv.Itoa(r.Limit))
+	}
+	if r.Offset != 0 {
+		v.Set("offset", strconv.Itoa(r.Offset))
+	}
+	return v
+}
+
+type ContentBlockListResponse struct {
+	Message       string                 `json:"message,omitempty"`
+	Count         int                    `json:"count"`
+	ContentBlocks []*ContentBlockSummary `json:"content_blocks,omitempty"`
+}
+
+type ContentBlockSummary struct {
+	ContentBlockID string    `json:"content_block_id"`
+	Name           string    `json:"name"`
+	ContentType    string    `json:"content_type"`
+	LiquidTag      string    `json:"liquid_tag"`
+	InclusionCount int       `json:"inclusion_count"`
+	CreatedAt      time.Time `json:"created_at"`
+	LastEdited     time.Time `json:"last_edited"`
+	Tags           []string  `json:"tags,omitempty"`
+}
+
+// https://www.braze.com/docs/api/endpoints/templates/content_blocks_templates/get_see_email_content_blocks_information/
+type ContentBlockInfoRequest struct {
+	ContentBlockID string
+
+	// Include the campaigns and canvases the content block is used in.
+	IncludeInclusionData bool
+}
+
+func (r *ContentBlockInfoRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.ContentBlockID == "" {
+		return errors.New("content block ID must not be empty")
+	}
+
+	return nil
+}
+
+func (r *ContentBlockInfoRequest) values() url.Values {
+	v := url.Values{}
+	v.Set("content_block_id", r.ContentBlockID)
+	if r.IncludeInclusionData {
+		v.Set("include_inclusion_data", "true")
+	}
+	return v
+}
+
+type ContentBlock struct {
+	ContentBlockID string                   `json:"content_block_id"`
+	Name           string                   `json:"name"`
+	Content        string                   `json:"content"`
+	Description    string                   `json:"description,omitempty"`
+	ContentType    string                   `json:"content_type"`
+	Tags           []string                 `json:"tags,omitempty"`
+	InclusionCount int                      `json:"inclusion_count"`
+	InclusionData  []*ContentBlockInclusion `json:"inclusion_data,omitempty"`
+	CreatedAt      time.Time                `json:"created_at"`
+	LastEdited     time.Time                `json:"last_edited"`
+}
+
+type ContentBlockInclusion struct {
+	CampaignID         string `json:"campaign_id,omitempty"`
+	CanvasID           string `json:"canvas_id,omitempty"`
+	MessageVariationID string `json:"message_variation_id,omitempty"`
+}
+
+func (s *ContentBlocksService) Create(ctx context.Context, r *ContentBlockCreateRequest) (*ContentBlockResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, contentBlocksCreatePath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ContentBlockResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *ContentBlocksService) Update(ctx context.Context, r *ContentBlockUpdateRequest) (*ContentBlockResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, contentBlocksUpdatePath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ContentBlockResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *ContentBlocksService) List(ctx context.Context, r *ContentBlockListRequest) (*ContentBlockListResponse, error) {
+	if r == nil {
+		return nil, errors.New("request must not be nil")
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, contentBlocksListPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res ContentBlockListResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *ContentBlocksService) Info(ctx context.Context, r *ContentBlockInfoRequest) (*ContentBlock, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, contentBlocksInfoPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res ContentBlock
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *ContentBlocksService) ContentBlocks(r *ContentBlockListRequest) *Iterator[*ContentBlockSummary] {
+	page := ContentBlockListRequest{}
+	if r != nil {
+		page = *r
+	}
+	if page.Limit == 0 {
+		page.Limit = contentBlocksListDefaultLimit
+	}
+
+	return newIterator(func(ctx context.Context) ([]*ContentBlockSummary, bool, error) {
+		res, err := s.List(ctx, &page)
+		if err != nil {
+			return nil, false, err
+		}
+		page.Offset += len(res.ContentBlocks)
+
+		return res.ContentBlocks, len(res.ContentBlocks) == page.Limit, nil
+	})
+}
@@ -0,0 +1,54 @@
+package braze_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentBlocksServiceCreate(t *testing.T) {
+	srv, client := createTestServer(t, "/content_blocks/create", func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"footer","content":"<footer/>","state":"draft","tags":["email"]}`, string(b))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"content_block_id":"cb1","liquid_tag":"{{content_blocks.${footer}}}","created_at":"2023-01-01T00:00:00Z","message":"success"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.ContentBlocks().Create(context.Background(), &braze.ContentBlockCreateRequest{
+		Name:    "footer",
+		Content: "<footer/>",
+		State:   &braze.ContentBlockStateDraft,
+		Tags:    []string{"email"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "cb1", resp.ContentBlockID)
+	assert.Equal(t, "{{content_blocks.${footer}}}", resp.LiquidTag)
+}
+
+func TestContentBlocksServiceInfo(t *testing.T) {
+	srv, client := createTestServer(t, "/content_blocks/info", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "cb1", r.URL.Query().Get("content_block_id"))
+		assert.Equal(t, "true", r.URL.Query().Get("include_inclusion_data"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"content_block_id":"cb1","name":"footer","content":"<footer/>","content_type":"html","inclusion_count":1,"inclusion_data":[{"campaign_id":"c1","message_variation_id":"v1"}],"created_at":"2023-01-01T00:00:00Z","last_edited":"2023-01-02T00:00:00Z"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.ContentBlocks().Info(context.Background(), &braze.ContentBlockInfoRequest{
+		ContentBlockID:       "cb1",
+		IncludeInclusionData: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "footer", resp.Name)
+	require.Len(t, resp.InclusionData, 1)
+	assert.Equal(t, "c1", resp.InclusionData[0].CampaignID)
+}
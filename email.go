@@ -0,0 +1,278 @@
+package braze
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	emailStatusPath       = "/email/status"
+	emailUnsubscribesPath = "/email/unsubscribes"
+	emailHardBouncesPath  = "/email/hard_bounces"
+	emailBlocklistPath    = "/email/blocklist"
+	emailBounceRemovePath = "/email/bounce/remove"
+	emailSpamRemovePath   = "/email/spam/remove"
+
+	emailQueryMaxLimit = 500
+
+	// Max number of email addresses Braze accepts in a single blocklist or
+	// removal request.
+	emailBatchMaxSize = 50
+)
+
+type EmailEndpoint interface {
+	SetStatus(ctx context.Context, r *EmailStatusRequest) (*Response, error)
+	Unsubscribes(ctx context.Context, r *EmailQueryRequest) (*EmailUnsubscribesResponse, error)
+	HardBounces(ctx context.Context, r *EmailQueryRequest) (*EmailHardBouncesResponse, error)
+	Blocklist(ctx context.Context, emails []string) (*Response, error)
+	RemoveBounces(ctx context.Context, emails []string) (*Response, error)
+	RemoveSpam(ctx context.Context, emails []string) (*Response, error)
+
+	// Batch variants accept any number of addresses, split them into requests
+	// of at most 50 and report the outcome for every address.
+	BlocklistBatch(ctx context.Context, emails []string) []*EmailBatchResult
+	RemoveBouncesBatch(ctx context.Context, emails []string) []*EmailBatchResult
+	RemoveSpamBatch(ctx context.Context, emails []string) []*EmailBatchResult
+}
+
+var _ EmailEndpoint = (*EmailService)(nil)
+
+type EmailService struct {
+	client *Client
+}
+
+// https://www.braze.com/docs/api/endpoints/email/post_email_subscription_status/
+type EmailStatusRequest struct {
+	// Email addresses to update, up to 50.
+	Email []string `json:"email"`
+
+	// Either AttributeSubscribeOptedIn, AttributeSubscribeUnsubscribed or
+	// AttributeSubscribeSubscribed.
+	SubscriptionState AttributeSubscribe `json:"subscription_state"`
+}
+
+func (r *EmailStatusRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if len(r.Email) == 0 {
+		return errors.New("email must not be empty")
+	}
+
+	if len(r.Email) > emailBatchMaxSize {
+		return fmt.Errorf("at most %d emails can be sent in a single request", emailBatchMaxSize)
+	}
+
+	if r.SubscriptionState == "" {
+		return errors.New("subscription state must not be empty")
+	}
+
+	return nil
+}
+
+// EmailQueryRequest queries email addresses either by date range or by a
+// single email address.
+type EmailQueryRequest struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+
+	// Max number of results to return, up to 500. Defaults to 100.
+	Limit  int
+	Offset int
+
+	SortDirection *SortDirection
+	Email         *string
+}
+
+func (r *EmailQueryRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.Email == nil && (r.StartDate == nil || r.EndDate == nil) {
+		return errors.New("either email or start and end dates must be set")
+	}
+
+	if r.Limit < 0 || r.Limit > emailQueryMaxLimit {
+		return errors.New("limit must be between 0 and 500")
+	}
+
+	if r.Offset < 0 {
+		return errors.New("offset must not be negative")
+	}
+
+	return nil
+}
+
+func (r *EmailQueryRequest) values() url.Values {
+	v := url.Values{}
+	if r.StartDate != nil {
+		v.Set("start_date", r.StartDate.Format(dateFormat))
+	}
+	if r.EndDate != nil {
+		v.Set("end_date", r.EndDate.Format(dateFormat))
+	}
+	if r.Limit != 0 {
+		v.Set("limit", strconv.Itoa(r.Limit))
+	}
+	if r.Offset != 0 {
+		v.Set("offset", strconv.Itoa(r.Offset))
+	}
+	if r.SortDirection != nil {
+		v.Set("sort_direction", string(*r.SortDirection))
+	}
+	if r.Email != nil {
+		v.Set("email", *r.Email)
+	}
+	return v
+}
+
+type EmailUnsubscribesResponse struct {
+	Message string              `json:"message,omitempty"`
+	Emails  []*EmailUnsubscribe `json:"emails,omitempty"`
+}
+
+type EmailUnsubscribe struct {
+	Email          string `json:"email"`
+	UnsubscribedAt string `json:"unsubscribed_at"`
+}
+
+type EmailHardBouncesResponse struct {
+	Message string             `json:"message,omitempty"`
+	Emails  []*EmailHardBounce `json:"emails,omitempty"`
+}
+
+type EmailHardBounce struct {
+	Email         string `json:"email"`
+	HardBouncedAt string `json:"hard_bounced_at"`
+}
+
+func (s *EmailService) SetStatus(ctx context.Context, r *EmailStatusRequest) (*Response, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, emailStatusPath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *EmailService) Unsubscribes(ctx context.Context, r *EmailQueryRequest) (*EmailUnsubscribesResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, emailUnsubscribesPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res EmailUnsubscribesResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *EmailService) HardBounces(ctx context.Context, r *EmailQueryRequest) (*EmailHardBouncesResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, emailHardBouncesPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res EmailHardBouncesResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// EmailBatchResult is the outcome of a batch operation for a single address.
+// Err is set when the request containing the address failed.
+type EmailBatchResult struct {
+	Email string
+	Err   error
+}
+
+func (s *EmailService) Blocklist(ctx context.Context, emails []string) (*Response, error) {
+	return s.postEmails(ctx, emailBlocklistPath, emails)
+}
+
+func (s *EmailService) RemoveBounces(ctx context.Context, emails []string) (*Response, error) {
+	return s.postEmails(ctx, emailBounceRemovePath, emails)
+}
+
+func (s *EmailService) RemoveSpam(ctx context.Context, emails []string) (*Response, error) {
+	return s.postEmails(ctx, emailSpamRemovePath, emails)
+}
+
+func (s *EmailService) BlocklistBatch(ctx context.Context, emails []string) []*EmailBatchResult {
+	return s.postEmailsBatch(ctx, emailBlocklistPath, emails)
+}
+
+func (s *EmailService) RemoveBouncesBatch(ctx context.Context, emails []string) []*EmailBatchResult {
+	return s.postEmailsBatch(ctx, emailBounceRemovePath, emails)
+}
+
+func (s *EmailService) RemoveSpamBatch(ctx context.Context, emails []string) []*EmailBatchResult {
+	return s.postEmailsBatch(ctx, emailSpamRemovePath, emails)
+}
+
+func (s *EmailService) postEmails(ctx context.Context, path string, emails []string) (*Response, error) {
+	if len(emails) == 0 {
+		return nil, errors.New("email must not be empty")
+	}
+
+	if len(emails) > emailBatchMaxSize {
+		return nil, fmt.Errorf("at most %d emails can be sent in a single request", emailBatchMaxSize)
+	}
+
+	body := struct {
+		Email []string `json:"email"`
+	}{
+		Email: emails,
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, path, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *EmailService) postEmailsBatch(ctx context.Context, path string, emails []string) []*EmailBatchResult {
+	results := make([]*EmailBatchResult, 0, len(emails))
+	for _, c := range chunk(emails, emailBatchMaxSize) {
+		_, err := s.postEmails(ctx, path, c)
+		for _, e := range c {
+			results = append(results, &EmailBatchResult{Email: e, Err: err})
+		}
+	}
+	return results
+}
@@ -0,0 +1,133 @@
+package braze_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailServiceSetStatus(t *testing.T) {
+	srv, client := createTestServer(t, "/email/status", func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"email":["foo@dietdoctor.com"],"subscription_state":"unsubscribed"}`, string(b))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"message":"success"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Email().SetStatus(context.Background(), &braze.EmailStatusRequest{
+		Email:             []string{"foo@dietdoctor.com"},
+		SubscriptionState: braze.AttributeSubscribeUnsubscribed,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "success", resp.Message)
+}
+
+func TestEmailServiceUnsubscribes(t *testing.T) {
+	srv, client := createTestServer(t, "/email/unsubscribes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "end_date=2023-01-31&limit=10&offset=20&sort_direction=asc&start_date=2023-01-01", r.URL.RawQuery)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","emails":[{"email":"foo@dietdoctor.com","unsubscribed_at":"2023-01-10T11:45:12Z"}]}`))
+	})
+	defer srv.Close()
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+	resp, err := client.Email().Unsubscribes(context.Background(), &braze.EmailQueryRequest{
+		StartDate:     &start,
+		EndDate:       &end,
+		Limit:         10,
+		Offset:        20,
+		SortDirection: &braze.SortDirectionAsc,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Emails, 1)
+	assert.Equal(t, "foo@dietdoctor.com", resp.Emails[0].Email)
+}
+
+func TestEmailServiceHardBouncesMissingRange(t *testing.T) {
+	srv, client := createTestServer(t, "/email/hard_bounces", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.Email().HardBounces(context.Background(), &braze.EmailQueryRequest{})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestEmailServiceRemoveSpamBatch(t *testing.T) {
+	var calls int
+	srv, client := createTestServer(t, "/email/spam/remove", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		var body struct {
+			Email []string `json:"email"`
+		}
+		require.NoError(t, json.Unmarshal(b, &body))
+		assert.LessOrEqual(t, len(body.Email), 50)
+
+		// Fail the last chunk.
+		if calls == 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"message":"invalid"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"message":"success"}`))
+	})
+	defer srv.Close()
+
+	emails := make([]string, 120)
+	for i := range emails {
+		emails[i] = fmt.Sprintf("user%d@dietdoctor.com", i)
+	}
+
+	results := client.Email().RemoveSpamBatch(context.Background(), emails)
+	assert.Equal(t, 3, calls)
+	require.Len(t, results, 120)
+	assert.Equal(t, "user0@dietdoctor.com", results[0].Email)
+	assert.NoError(t, results[99].Err)
+	assert.Error(t, results[100].Err)
+	assert.Equal(t, "user119@dietdoctor.com", results[119].Email)
+}
+
+func TestEmailServiceBlocklistTooManyEmails(t *testing.T) {
+	srv, client := createTestServer(t, "/email/blocklist", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.Email().Blocklist(context.Background(), make([]string, 51))
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestEmailServiceSetStatusTooManyEmails(t *testing.T) {
+	srv, client := createTestServer(t, "/email/status", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.Email().SetStatus(context.Background(), &braze.EmailStatusRequest{
+		Email:             make([]string, 51),
+		SubscriptionState: braze.AttributeSubscribeOptedIn,
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,111 @@
+package braze
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	eventsListPath       = "/events/list"
+	eventsDataSeriesPath = "/events/data_series"
+
+	eventsListPageSize = 250
+)
+
+type EventsEndpoint interface {
+	// List returns a page of custom event names, starting at page 0.
+	List(ctx context.Context, page int) (*EventListResponse, error)
+	DataSeries(ctx context.Context, r *EventDataSeriesRequest) (*TimeSeries, error)
+
+	// Events returns an iterator over all custom event names.
+	Events() *Iterator[string]
+}
+
+var _ EventsEndpoint = (*EventsService)(nil)
+
+type EventsService struct {
+	client *Client
+}
+
+// https://www.braze.com/docs/api/endpoints/export/custom_events/get_custom_events/
+type EventListResponse struct {
+	Message string   `json:"message,omitempty"`
+	Events  []string `json:"events,omitempty"`
+}
+
+// https://www.braze.com/docs/api/endpoints/export/custom_events/get_custom_events_analytics/
+type EventDataSeriesRequest struct {
+	Event string
+	TimeSeriesRequest
+}
+
+func (r *EventDataSeriesRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.Event == "" {
+		return errors.New("event must not be empty")
+	}
+
+	return r.TimeSeriesRequest.validate()
+}
+
+func (r *EventDataSeriesRequest) values() url.Values {
+	v := r.TimeSeriesRequest.values()
+	v.Set("event", r.Event)
+	return v
+}
+
+func (s *EventsService) List(ctx context.Context, page int) (*EventListResponse, error) {
+	query := url.Values{}
+	if page != 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, eventsListPath, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var res EventListResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *EventsService) DataSeries(ctx context.Context, r *EventDataSeriesRequest) (*TimeSeries, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, eventsDataSeriesPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res timeSeriesResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return res.timeSeries("count")
+}
+
+func (s *EventsService) Events() *Iterator[string] {
+	var page int
+	return newIterator(func(ctx context.Context) ([]string, bool, error) {
+		res, err := s.List(ctx, page)
+		if err != nil {
+			return nil, false, err
+		}
+		page++
+
+		return res.Events, len(res.Events) == eventsListPageSize, nil
+	})
+}
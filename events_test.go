@@ -0,0 +1,53 @@
+package braze_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsServiceDataSeries(t *testing.T) {
+	srv, client := createTestServer(t, "/events/data_series", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "recipe_saved", q.Get("event"))
+		assert.Equal(t, "24", q.Get("length"))
+		assert.Equal(t, "hour", q.Get("unit"))
+		assert.Equal(t, "s1", q.Get("segment_id"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","data":[{"time":"2023-01-01T10:00:00","count":42}]}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Events().DataSeries(context.Background(), &braze.EventDataSeriesRequest{
+		Event: "recipe_saved",
+		TimeSeriesRequest: braze.TimeSeriesRequest{
+			Length:    24,
+			Unit:      &braze.TimeSeriesUnitHour,
+			SegmentID: braze.String("s1"),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*braze.TimeSeriesEntry{{Time: "2023-01-01T10:00:00", Value: 42}}, resp.Data)
+}
+
+func TestEventsServiceEventsIterator(t *testing.T) {
+	srv, client := createTestServer(t, "/events/list", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","events":["recipe_saved","meal_plan_started"]}`))
+	})
+	defer srv.Close()
+
+	it := client.Events().Events()
+
+	var events []string
+	for it.Next(context.Background()) {
+		events = append(events, it.Value())
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"recipe_saved", "meal_plan_started"}, events)
+}
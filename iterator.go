@@ -0,0 +1,59 @@
+package braze
+
+import "context"
+
+// Iterator walks over the results of a paginated list endpoint, fetching the
+// next page when the current one is exhausted.
+//
+//	it := client.Templates().EmailTemplates(&braze.EmailTemplateListRequest{})
+//	for it.Next(ctx) {
+//		t := it.Value()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type Iterator[T any] struct {
+	// fetch returns the next page and whether more pages may follow.
+	fetch func(ctx context.Context) ([]T, bool, error)
+
+	items []T
+	cur   T
+	done  bool
+	err   error
+}
+
+func newIterator[T any](fetch func(ctx context.Context) ([]T, bool, error)) *Iterator[T] {
+	return &Iterator[T]{fetch: fetch}
+}
+
+// Next advances the iterator to the next item. It returns false when there are
+// no more items or an error occurred.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	for len(it.items) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+
+		items, more, err := it.fetch(ctx)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.items = items
+		it.done = !more
+	}
+
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Value returns the current item.
+func (it *Iterator[T]) Value() T {
+	return it.cur
+}
+
+// Err returns the first error encountered while fetching pages.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
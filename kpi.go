@@ -0,0 +1,101 @@
+package braze
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	kpiDAUPath        = "/kpi/dau/data_series"
+	kpiMAUPath        = "/kpi/mau/data_series"
+	kpiNewUsersPath   = "/kpi/new_users/data_series"
+	kpiUninstallsPath = "/kpi/uninstalls/data_series"
+)
+
+type KPIEndpoint interface {
+	DAU(ctx context.Context, r *KPIDataSeriesRequest) (*TimeSeries, error)
+	MAU(ctx context.Context, r *KPIDataSeriesRequest) (*TimeSeries, error)
+	NewUsers(ctx context.Context, r *KPIDataSeriesRequest) (*TimeSeries, error)
+	Uninstalls(ctx context.Context, r *KPIDataSeriesRequest) (*TimeSeries, error)
+}
+
+var _ KPIEndpoint = (*KPIService)(nil)
+
+type KPIService struct {
+	client *Client
+}
+
+// https://www.braze.com/docs/api/endpoints/export/kpi/get_kpi_dau_date/
+type KPIDataSeriesRequest struct {
+	// Max number of days before EndingAt to include in the returned series.
+	// Must be between 1 and 100 (inclusive).
+	Length int
+
+	// Date on which the data series should end. Defaults to time of the request.
+	EndingAt *time.Time
+
+	// Restrict the series to a single app. Defaults to all apps.
+	AppID *string
+}
+
+func (r *KPIDataSeriesRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.Length < 1 || r.Length > 100 {
+		return errors.New("length must be between 1 and 100")
+	}
+
+	return nil
+}
+
+func (r *KPIDataSeriesRequest) values() url.Values {
+	v := url.Values{}
+	v.Set("length", strconv.Itoa(r.Length))
+	if r.EndingAt != nil {
+		v.Set("ending_at", r.EndingAt.Format(time.RFC3339))
+	}
+	if r.AppID != nil {
+		v.Set("app_id", *r.AppID)
+	}
+	return v
+}
+
+func (s *KPIService) DAU(ctx context.Context, r *KPIDataSeriesRequest) (*TimeSeries, error) {
+	return s.dataSeries(ctx, kpiDAUPath, "dau", r)
+}
+
+func (s *KPIService) MAU(ctx context.Context, r *KPIDataSeriesRequest) (*TimeSeries, error) {
+	return s.dataSeries(ctx, kpiMAUPath, "mau", r)
+}
+
+func (s *KPIService) NewUsers(ctx context.Context, r *KPIDataSeriesRequest) (*TimeSeries, error) {
+	return s.dataSeries(ctx, kpiNewUsersPath, "new_users", r)
+}
+
+func (s *KPIService) Uninstalls(ctx context.Context, r *KPIDataSeriesRequest) (*TimeSeries, error) {
+	return s.dataSeries(ctx, kpiUninstallsPath, "uninstalls", r)
+}
+
+func (s *KPIService) dataSeries(ctx context.Context, path, metric string, r *KPIDataSeriesRequest) (*TimeSeries, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, path, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res timeSeriesResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return res.timeSeries(metric)
+}
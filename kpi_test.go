@@ -0,0 +1,60 @@
+package braze_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKPIServiceDAU(t *testing.T) {
+	srv, client := createTestServer(t, "/kpi/dau/data_series", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "2", q.Get("length"))
+		assert.Equal(t, "2023-01-02T00:00:00Z", q.Get("ending_at"))
+		assert.Equal(t, "app", q.Get("app_id"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","data":[{"time":"2023-01-01","dau":1200},{"time":"2023-01-02","dau":1300}]}`))
+	})
+	defer srv.Close()
+
+	end := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	resp, err := client.KPI().DAU(context.Background(), &braze.KPIDataSeriesRequest{
+		Length:   2,
+		EndingAt: &end,
+		AppID:    braze.String("app"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*braze.TimeSeriesEntry{
+		{Time: "2023-01-01", Value: 1200},
+		{Time: "2023-01-02", Value: 1300},
+	}, resp.Data)
+}
+
+func TestKPIServiceMAUExtraFields(t *testing.T) {
+	srv, client := createTestServer(t, "/kpi/mau/data_series", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","data":[{"time":"2023-01-01","mau":5000,"dau":1200,"app":"ios"}]}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.KPI().MAU(context.Background(), &braze.KPIDataSeriesRequest{Length: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []*braze.TimeSeriesEntry{{Time: "2023-01-01", Value: 5000}}, resp.Data)
+}
+
+func TestKPIServiceUninstallsInvalidLength(t *testing.T) {
+	srv, client := createTestServer(t, "/kpi/uninstalls/data_series", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.KPI().Uninstalls(context.Background(), &braze.KPIDataSeriesRequest{Length: 101})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
@@ -2,13 +2,30 @@ package braze
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"time"
 )
 
 const (
+	webPushMaxTimeToLive = 2419200
+
 	messagingMessagesSendPath         = "/messages/send"
 	messagingTransactionalSendPath    = "/transactional/v1/campaigns/%s/send"
 	messagingCampaignsTriggerSendPath = "/campaigns/trigger/send"
+
+	messagingCampaignsTriggerScheduleCreatePath = "/campaigns/trigger/schedule/create"
+	messagingCampaignsTriggerScheduleUpdatePath = "/campaigns/trigger/schedule/update"
+	messagingCampaignsTriggerScheduleDeletePath = "/campaigns/trigger/schedule/delete"
+	messagingCanvasTriggerScheduleCreatePath    = "/canvas/trigger/schedule/create"
+	messagingCanvasTriggerScheduleUpdatePath    = "/canvas/trigger/schedule/update"
+	messagingCanvasTriggerScheduleDeletePath    = "/canvas/trigger/schedule/delete"
+	messagingMessagesScheduleCreatePath         = "/messages/schedule/create"
+	messagingMessagesScheduleUpdatePath         = "/messages/schedule/update"
+	messagingMessagesScheduleDeletePath         = "/messages/schedule/delete"
+	messagingLiveActivityUpdatePath             = "/messages/live_activity/update"
+	messagingLiveActivityStartPath              = "/messages/live_activity/start"
 )
 
 var (
@@ -25,11 +42,82 @@ var (
 	ApplePushMessageFileTypeMP4 ApplePushMessageFileType = "mp4"
 	ApplePushMessageFileTypePNG ApplePushMessageFileType = "png"
 	ApplePushMessageFileTypeWAV ApplePushMessageFileType = "wav"
+
+	WebhookRequestMethodGet    WebhookRequestMethod = "GET"
+	WebhookRequestMethodPost   WebhookRequestMethod = "POST"
+	WebhookRequestMethodPut    WebhookRequestMethod = "PUT"
+	WebhookRequestMethodDelete WebhookRequestMethod = "DELETE"
+
+	ContentCardTypeClassic        ContentCardType = "CLASSIC"
+	ContentCardTypeCaptionedImage ContentCardType = "CAPTIONED_IMAGE"
+	ContentCardTypeBanner         ContentCardType = "BANNER"
+
+	InAppMessageTypeSlideup InAppMessageType = "SLIDEUP"
+	InAppMessageTypeModal   InAppMessageType = "MODAL"
+	InAppMessageTypeFull    InAppMessageType = "FULL"
+	InAppMessageTypeHTML    InAppMessageType = "HTML"
+
+	InAppMessageClickActionNone     InAppMessageClickAction = "NONE"
+	InAppMessageClickActionURI      InAppMessageClickAction = "URI"
+	InAppMessageClickActionNewsFeed InAppMessageClickAction = "NEWS_FEED"
+
+	InAppMessageCloseSwipe       InAppMessageClose = "SWIPE"
+	InAppMessageCloseAutoDismiss InAppMessageClose = "AUTO_DISMISS"
+
+	InAppMessageSlideFromTop    InAppMessageSlideFrom = "TOP"
+	InAppMessageSlideFromBottom InAppMessageSlideFrom = "BOTTOM"
+
+	InAppMessageImageStyleTop     InAppMessageImageStyle = "TOP"
+	InAppMessageImageStyleGraphic InAppMessageImageStyle = "GRAPHIC"
+
+	InAppMessageCropTypeFitCenter  InAppMessageCropType = "FIT_CENTER"
+	InAppMessageCropTypeCenterCrop InAppMessageCropType = "CENTER_CROP"
+
+	InAppMessageOrientationAny       InAppMessageOrientation = "ANY"
+	InAppMessageOrientationPortrait  InAppMessageOrientation = "PORTRAIT"
+	InAppMessageOrientationLandscape InAppMessageOrientation = "LANDSCAPE"
+
+	InAppMessageTextAlignStart  InAppMessageTextAlign = "START"
+	InAppMessageTextAlignCenter InAppMessageTextAlign = "CENTER"
+	InAppMessageTextAlignEnd    InAppMessageTextAlign = "END"
+
+	HuaweiPushCategoryIM             HuaweiPushCategory   = "IM"
+	HuaweiPushCategoryVOIP           HuaweiPushCategory   = "VOIP"
+	HuaweiPushCategorySubscription   HuaweiPushCategory   = "SUBSCRIPTION"
+	HuaweiPushCategoryTravel         HuaweiPushCategory   = "TRAVEL"
+	HuaweiPushCategoryHealth         HuaweiPushCategory   = "HEALTH"
+	HuaweiPushCategoryWork           HuaweiPushCategory   = "WORK"
+	HuaweiPushCategoryAccount        HuaweiPushCategory   = "ACCOUNT"
+	HuaweiPushCategoryExpress        HuaweiPushCategory   = "EXPRESS"
+	HuaweiPushCategoryFinance        HuaweiPushCategory   = "FINANCE"
+	HuaweiPushCategoryDeviceReminder HuaweiPushCategory   = "DEVICE_REMINDER"
+	HuaweiPushCategoryMail           HuaweiPushCategory   = "MAIL"
+	HuaweiPushCategoryMarketing      HuaweiPushCategory   = "MARKETING"
+	HuaweiPushImportanceNormal       HuaweiPushImportance = "NORMAL"
+	HuaweiPushImportanceLow          HuaweiPushImportance = "LOW"
+
+	WebPushActionButtonActionOpenApp WebPushActionButtonAction = "OPEN_APP"
+	WebPushActionButtonActionURI     WebPushActionButtonAction = "URI"
+	WebPushActionButtonActionClose   WebPushActionButtonAction = "CLOSE"
 )
 
 type MessagingEndpoint interface {
 	SendMessages(context.Context, *SendMessagesRequest) (*Response, error)
 	TriggerCampaign(context.Context, *TriggerCampaignRequest) (*Response, error)
+
+	ScheduleMessages(context.Context, *ScheduleMessagesRequest) (*ScheduleResponse, error)
+	UpdateScheduledMessages(context.Context, *UpdateScheduledMessagesRequest) (*Response, error)
+	DeleteScheduledMessages(context.Context, *DeleteScheduledMessagesRequest) (*Response, error)
+
+	ScheduleTriggerCampaign(context.Context, *ScheduleTriggerCampaignRequest) (*ScheduleResponse, error)
+	UpdateScheduledTriggerCampaign(context.Context, *UpdateScheduledTriggerCampaignRequest) (*Response, error)
+	DeleteScheduledTriggerCampaign(context.Context, *DeleteScheduledTriggerCampaignRequest) (*Response, error)
+	ScheduleTriggerCanvas(context.Context, *ScheduleTriggerCanvasRequest) (*ScheduleResponse, error)
+	UpdateScheduledTriggerCanvas(context.Context, *UpdateScheduledTriggerCanvasRequest) (*Response, error)
+	DeleteScheduledTriggerCanvas(context.Context, *DeleteScheduledTriggerCanvasRequest) (*Response, error)
+
+	StartLiveActivity(context.Context, *LiveActivityStartRequest) (*Response, error)
+	UpdateLiveActivity(context.Context, *LiveActivityUpdateRequest) (*Response, error)
 }
 
 var _ MessagingEndpoint = (*MessagingService)(nil)
@@ -39,6 +127,10 @@ type MessagingService struct {
 }
 
 func (s *MessagingService) SendMessages(ctx context.Context, r *SendMessagesRequest) (*Response, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
 	req, err := s.client.http.newRequest(http.MethodPost, messagingMessagesSendPath, r)
 	if err != nil {
 		return nil, err
@@ -66,14 +158,254 @@ func (s *MessagingService) TriggerCampaign(ctx context.Context, r *TriggerCampai
 	return &res, nil
 }
 
+func (s *MessagingService) ScheduleMessages(ctx context.Context, r *ScheduleMessagesRequest) (*ScheduleResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, messagingMessagesScheduleCreatePath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ScheduleResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *MessagingService) UpdateScheduledMessages(ctx context.Context, r *UpdateScheduledMessagesRequest) (*Response, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, messagingMessagesScheduleUpdatePath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *MessagingService) DeleteScheduledMessages(ctx context.Context, r *DeleteScheduledMessagesRequest) (*Response, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, messagingMessagesScheduleDeletePath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *MessagingService) ScheduleTriggerCampaign(ctx context.Context, r *ScheduleTriggerCampaignRequest) (*ScheduleResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, messagingCampaignsTriggerScheduleCreatePath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ScheduleResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *MessagingService) UpdateScheduledTriggerCampaign(ctx context.Context, r *UpdateScheduledTriggerCampaignRequest) (*Response, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, messagingCampaignsTriggerScheduleUpdatePath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *MessagingService) DeleteScheduledTriggerCampaign(ctx context.Context, r *DeleteScheduledTriggerCampaignRequest) (*Response, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, messagingCampaignsTriggerScheduleDeletePath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *MessagingService) ScheduleTriggerCanvas(ctx context.Context, r *ScheduleTriggerCanvasRequest) (*ScheduleResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, messagingCanvasTriggerScheduleCreatePath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ScheduleResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *MessagingService) UpdateScheduledTriggerCanvas(ctx context.Context, r *UpdateScheduledTriggerCanvasRequest) (*Response, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, messagingCanvasTriggerScheduleUpdatePath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *MessagingService) DeleteScheduledTriggerCanvas(ctx context.Context, r *DeleteScheduledTriggerCanvasRequest) (*Response, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, messagingCanvasTriggerScheduleDeletePath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *MessagingService) StartLiveActivity(ctx context.Context, r *LiveActivityStartRequest) (*Response, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, messagingLiveActivityStartPath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *MessagingService) UpdateLiveActivity(ctx context.Context, r *LiveActivityUpdateRequest) (*Response, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, messagingLiveActivityUpdatePath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 type SendMessagesRequest struct {
-	Messages *Messages
+	// Segment to send the messages to, requires Broadcast to be set.
+	SegmentID *string   `json:"segment_id,omitempty"`
+	Broadcast *bool     `json:"broadcast,omitempty"`
+	Messages  *Messages `json:"messages,omitempty"`
+}
+
+func (r *SendMessagesRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.SegmentID != nil && (r.Broadcast == nil || !*r.Broadcast) {
+		return errors.New("broadcast must be set when sending to a segment")
+	}
+
+	return r.Messages.validate()
 }
 
 type Messages struct {
-	AndroidPush *AndroidPushMessage
-	ApplePush   *ApplePushMessage
-	Email       *EmailMessage
+	AndroidPush *AndroidPushMessage `json:"android_push,omitempty"`
+	ApplePush   *ApplePushMessage   `json:"apple_push,omitempty"`
+	Email       *EmailMessage       `json:"email,omitempty"`
+	SMS         *SMSMessage         `json:"sms,omitempty"`
+	Webhook     *WebhookMessage     `json:"webhook,omitempty"`
+	ContentCard *ContentCardMessage `json:"content_card,omitempty"`
+	InApp       *InAppMessage       `json:"in_app_message,omitempty"`
+	KindlePush  *KindlePushMessage  `json:"kindle_push,omitempty"`
+	HuaweiPush  *HuaweiPushMessage  `json:"huawei_push,omitempty"`
+	WebPush     *WebPushMessage     `json:"web_push,omitempty"`
+}
+
+func (m *Messages) validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.SMS != nil {
+		if err := m.SMS.validate(); err != nil {
+			return err
+		}
+	}
+
+	if m.WebPush != nil {
+		if err := m.WebPush.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // https://www.braze.com/docs/api/objects_filters/messaging/android_object/
@@ -190,6 +522,346 @@ type EmailMessageAttachment struct {
 	URL      string `json:"url"`
 }
 
+// https://www.braze.com/docs/api/objects_filters/messaging/sms_object/
+type SMSMessage struct {
+	// The subscription group the message is sent through. Required.
+	SubscriptionGroupID string  `json:"subscription_group_id"`
+	MessageVariationID  *string `json:"message_variation_id,omitempty"`
+	Body                string  `json:"body"`
+	AppID               string  `json:"app_id"`
+
+	// Media attached to the message. Setting any turns the message into an MMS.
+	MediaItems []*SMSMediaItem `json:"media_items,omitempty"`
+}
+
+func (m *SMSMessage) validate() error {
+	if m.SubscriptionGroupID == "" {
+		return errors.New("sms subscription group ID must not be empty")
+	}
+
+	if m.Body == "" {
+		return errors.New("sms body must not be empty")
+	}
+
+	if m.AppID == "" {
+		return errors.New("sms app ID must not be empty")
+	}
+
+	return nil
+}
+
+type SMSMediaItem struct {
+	URL string `json:"url"`
+}
+
+// https://www.braze.com/docs/api/objects_filters/messaging/webhook_object/
+type WebhookMessage struct {
+	URL                string               `json:"url"`
+	RequestMethod      WebhookRequestMethod `json:"request_method"`
+	RequestHeaders     map[string]string    `json:"request_headers,omitempty"`
+	Body               *string              `json:"body,omitempty"`
+	MessageVariationID *string              `json:"message_variation_id,omitempty"`
+}
+
+// https://www.braze.com/docs/api/objects_filters/messaging/content_cards_object/
+type ContentCardMessage struct {
+	Type               ContentCardType `json:"type"`
+	Title              *string         `json:"title,omitempty"`
+	Message            string          `json:"message"`
+	ImageURL           *string         `json:"image_url,omitempty"`
+	Pinned             *bool           `json:"pinned,omitempty"`
+	Dismissible        *bool           `json:"dismissible,omitempty"`
+	URL                *string         `json:"url,omitempty"`
+	OpenInNewWindow    *bool           `json:"open_in_new_window,omitempty"`
+	UseWebview         *bool           `json:"use_webview,omitempty"`
+	Extra              map[string]any  `json:"extra,omitempty"`
+	MessageVariationID *string         `json:"message_variation_id,omitempty"`
+
+	// Seconds until the card expires. Mutually exclusive with ExpireAt.
+	ExpireIn *int `json:"expire_in,omitempty"`
+	// Expiration time in ISO 8601 format. Mutually exclusive with ExpireIn.
+	ExpireAt *string `json:"expire_at,omitempty"`
+}
+
+// https://www.braze.com/docs/api/objects_filters/messaging/in_app_message_object/
+//
+// Fields specific to a template are ignored for the other message types.
+// Colors are hex strings, e.g. "#0073D1".
+type InAppMessage struct {
+	Type        InAppMessageType         `json:"type"`
+	Message     string                   `json:"message"`
+	ClickAction *InAppMessageClickAction `json:"click_action,omitempty"`
+	URI         *string                  `json:"uri,omitempty"`
+	UseWebView  *bool                    `json:"use_webview,omitempty"`
+
+	// How the message is dismissed. Defaults to AUTO_DISMISS for slideups and
+	// SWIPE otherwise.
+	MessageClose *InAppMessageClose `json:"message_close,omitempty"`
+	// Milliseconds the message is displayed when auto dismissed.
+	Duration   *int  `json:"duration,omitempty"`
+	AnimateIn  *bool `json:"animate_in,omitempty"`
+	AnimateOut *bool `json:"animate_out,omitempty"`
+
+	// Modal and full screen messages.
+	Header           *string                 `json:"header,omitempty"`
+	HeaderTextColor  *string                 `json:"header_text_color,omitempty"`
+	TextAlignHeader  *InAppMessageTextAlign  `json:"text_align_header,omitempty"`
+	TextAlignMessage *InAppMessageTextAlign  `json:"text_align_message,omitempty"`
+	ImageStyle       *InAppMessageImageStyle `json:"image_style,omitempty"`
+	CropType         *InAppMessageCropType   `json:"crop_type,omitempty"`
+	CloseButtonColor *string                 `json:"close_btn_color,omitempty"`
+	FrameColor       *string                 `json:"frame_color,omitempty"`
+	Buttons          []*InAppMessageButton   `json:"buttons,omitempty"`
+
+	// Full screen messages.
+	Orientation *InAppMessageOrientation `json:"orientation,omitempty"`
+
+	// Slideup messages.
+	SlideFrom *InAppMessageSlideFrom `json:"slide_from,omitempty"`
+
+	// Font Awesome icon shown instead of the image.
+	Icon                *string `json:"icon,omitempty"`
+	IconColor           *string `json:"icon_color,omitempty"`
+	IconBackgroundColor *string `json:"icon_bg_color,omitempty"`
+	ImageURL            *string `json:"image_url,omitempty"`
+	BackgroundColor     *string `json:"bg_color,omitempty"`
+	TextColor           *string `json:"text_color,omitempty"`
+
+	Extra              map[string]any `json:"extra,omitempty"`
+	MessageVariationID *string        `json:"message_variation_id,omitempty"`
+}
+
+type InAppMessageButton struct {
+	Text            string                   `json:"text"`
+	ClickAction     *InAppMessageClickAction `json:"click_action,omitempty"`
+	URI             *string                  `json:"uri,omitempty"`
+	UseWebView      *bool                    `json:"use_webview,omitempty"`
+	BackgroundColor *string                  `json:"bg_color,omitempty"`
+	TextColor       *string                  `json:"text_color,omitempty"`
+	BorderColor     *string                  `json:"border_color,omitempty"`
+}
+
+// https://www.braze.com/docs/api/objects_filters/messaging/kindle_and_fireos_object/
+type KindlePushMessage struct {
+	Alert              string         `json:"alert"`
+	Title              string         `json:"title"`
+	Extra              map[string]any `json:"extra,omitempty"`
+	MessageVariationID *string        `json:"message_variation_id,omitempty"`
+	Priority           *int           `json:"priority,omitempty"`
+	CollapseKey        *string        `json:"collapse_key,omitempty"`
+	TimeToLive         *int           `json:"time_to_live,omitempty"`
+	CustomURI          *string        `json:"custom_uri,omitempty"`
+}
+
+// https://www.braze.com/docs/api/objects_filters/messaging/huawei_object/
+type HuaweiPushMessage struct {
+	Alert              string                `json:"alert"`
+	Title              string                `json:"title"`
+	Extra              map[string]any        `json:"extra,omitempty"`
+	MessageVariationID *string               `json:"message_variation_id,omitempty"`
+	Category           *HuaweiPushCategory   `json:"category,omitempty"`
+	Importance         *HuaweiPushImportance `json:"importance,omitempty"`
+	CustomURI          *string               `json:"custom_uri,omitempty"`
+	ImageURL           *string               `json:"image_url,omitempty"`
+	SummaryText        *string               `json:"summary_text,omitempty"`
+	TimeToLive         *int                  `json:"time_to_live,omitempty"`
+}
+
+// https://www.braze.com/docs/api/objects_filters/messaging/web_objects/
+type WebPushMessage struct {
+	Alert              string  `json:"alert"`
+	Title              string  `json:"title"`
+	CustomURI          *string `json:"custom_uri,omitempty"`
+	ImageURL           *string `json:"image_url,omitempty"`
+	LargeImageURL      *string `json:"large_image_url,omitempty"`
+	RequireInteraction *bool   `json:"require_interaction,omitempty"`
+
+	// Seconds the message is kept for delivery, at most 2419200 (28 days).
+	TimeToLive                 *int                   `json:"time_to_live,omitempty"`
+	SendToMostRecentDeviceOnly *bool                  `json:"send_to_most_recent_device_only,omitempty"`
+	Buttons                    []*WebPushActionButton `json:"buttons,omitempty"`
+	Extra                      map[string]any         `json:"extra,omitempty"`
+	MessageVariationID         *string                `json:"message_variation_id,omitempty"`
+}
+
+func (m *WebPushMessage) validate() error {
+	if m.Alert == "" {
+		return errors.New("web push alert must not be empty")
+	}
+
+	if m.Title == "" {
+		return errors.New("web push title must not be empty")
+	}
+
+	if m.TimeToLive != nil && (*m.TimeToLive < 0 || *m.TimeToLive > webPushMaxTimeToLive) {
+		return fmt.Errorf("web push time to live must be between 0 and %d seconds", webPushMaxTimeToLive)
+	}
+
+	return nil
+}
+
+type WebPushActionButton struct {
+	Text   string                     `json:"text"`
+	Action *WebPushActionButtonAction `json:"action,omitempty"`
+	URI    *string                    `json:"uri,omitempty"`
+}
+
+type (
+	WebhookRequestMethod      string
+	ContentCardType           string
+	InAppMessageType          string
+	InAppMessageClickAction   string
+	InAppMessageClose         string
+	InAppMessageSlideFrom     string
+	InAppMessageImageStyle    string
+	InAppMessageCropType      string
+	InAppMessageOrientation   string
+	InAppMessageTextAlign     string
+	HuaweiPushCategory        string
+	HuaweiPushImportance      string
+	WebPushActionButtonAction string
+)
+
+// https://www.braze.com/docs/api/endpoints/messaging/schedule_messages/post_schedule_messages/
+type ScheduleMessagesRequest struct {
+	SendMessagesRequest
+	Schedule *Schedule `json:"schedule"`
+}
+
+func (r *ScheduleMessagesRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.Schedule == nil {
+		return errors.New("schedule must not be nil")
+	}
+
+	return r.SendMessagesRequest.validate()
+}
+
+type UpdateScheduledMessagesRequest struct {
+	ScheduleID string    `json:"schedule_id"`
+	Schedule   *Schedule `json:"schedule,omitempty"`
+	Messages   *Messages `json:"messages,omitempty"`
+}
+
+func (r *UpdateScheduledMessagesRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.ScheduleID == "" {
+		return errors.New("schedule ID must not be empty")
+	}
+
+	return r.Messages.validate()
+}
+
+type DeleteScheduledMessagesRequest struct {
+	ScheduleID string `json:"schedule_id"`
+}
+
+func (r *DeleteScheduledMessagesRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.ScheduleID == "" {
+		return errors.New("schedule ID must not be empty")
+	}
+
+	return nil
+}
+
+// https://www.braze.com/docs/api/endpoints/messaging/live_activity/start/
+type LiveActivityStartRequest struct {
+	AppID      string `json:"app_id"`
+	ActivityID string `json:"activity_id"`
+
+	// Name of the ActivityAttributes type of the activity in the app.
+	ActivityAttributesType string `json:"activity_attributes_type"`
+	// Static data of the activity, must match ActivityAttributesType.
+	ActivityAttributes any `json:"activity_attributes,omitempty"`
+
+	// Dynamic data of the activity, must match the ContentState of the
+	// ActivityAttributes type.
+	ContentState any `json:"content_state"`
+
+	DismissalDate   *time.Time                `json:"dismissal_date,omitempty"`
+	StaleDate       *time.Time                `json:"stale_date,omitempty"`
+	Notification    *LiveActivityNotification `json:"notification,omitempty"`
+	ExternalUserIDs []string                  `json:"external_user_ids,omitempty"`
+	SegmentID       *string                   `json:"segment_id,omitempty"`
+	Broadcast       *bool                     `json:"broadcast,omitempty"`
+}
+
+func (r *LiveActivityStartRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.AppID == "" {
+		return errors.New("app ID must not be empty")
+	}
+
+	if r.ActivityID == "" {
+		return errors.New("activity ID must not be empty")
+	}
+
+	if r.ActivityAttributesType == "" {
+		return errors.New("activity attributes type must not be empty")
+	}
+
+	if r.ContentState == nil {
+		return errors.New("content state must not be nil")
+	}
+
+	return nil
+}
+
+// https://www.braze.com/docs/api/endpoints/messaging/live_activity/update/
+type LiveActivityUpdateRequest struct {
+	AppID      string `json:"app_id"`
+	ActivityID string `json:"activity_id"`
+
+	// Dynamic data of the activity, must match the ContentState of the
+	// ActivityAttributes type.
+	ContentState any `json:"content_state"`
+
+	// End the activity. It stays on the lock screen until DismissalDate.
+	EndActivity   *bool                     `json:"end_activity,omitempty"`
+	DismissalDate *time.Time                `json:"dismissal_date,omitempty"`
+	StaleDate     *time.Time                `json:"stale_date,omitempty"`
+	Notification  *LiveActivityNotification `json:"notification,omitempty"`
+}
+
+func (r *LiveActivityUpdateRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.AppID == "" {
+		return errors.New("app ID must not be empty")
+	}
+
+	if r.ActivityID == "" {
+		return errors.New("activity ID must not be empty")
+	}
+
+	if r.ContentState == nil {
+		return errors.New("content state must not be nil")
+	}
+
+	return nil
+}
+
+// LiveActivityNotification alerts the user about a live activity start or
+// update.
+type LiveActivityNotification struct {
+	Alert *ApplePushAlert `json:"alert"`
+}
+
 type TriggerCampaignRequest struct {
 	CampaignID        string         `json:"campaign_id,omitempty"`
 	SendID            *string        `json:"send_id,omitempty"`
@@ -206,3 +878,160 @@ type Recipient struct {
 	CanvasEntryProperties map[string]any `json:"canvas_entry_properties,omitempty"`
 	SendToExistingOnly    *bool          `json:"send_to_existing_only,omitempty"`
 }
+
+// https://www.braze.com/docs/api/endpoints/messaging/schedule_messages/post_schedule_triggered_campaigns/
+type ScheduleTriggerCampaignRequest struct {
+	TriggerCampaignRequest
+	Schedule *Schedule `json:"schedule"`
+}
+
+func (r *ScheduleTriggerCampaignRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.CampaignID == "" {
+		return errors.New("campaign ID must not be empty")
+	}
+
+	if r.Schedule == nil {
+		return errors.New("schedule must not be nil")
+	}
+
+	return nil
+}
+
+type UpdateScheduledTriggerCampaignRequest struct {
+	CampaignID string    `json:"campaign_id"`
+	ScheduleID string    `json:"schedule_id"`
+	Schedule   *Schedule `json:"schedule"`
+}
+
+func (r *UpdateScheduledTriggerCampaignRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.CampaignID == "" {
+		return errors.New("campaign ID must not be empty")
+	}
+
+	if r.ScheduleID == "" {
+		return errors.New("schedule ID must not be empty")
+	}
+
+	if r.Schedule == nil {
+		return errors.New("schedule must not be nil")
+	}
+
+	return nil
+}
+
+type DeleteScheduledTriggerCampaignRequest struct {
+	CampaignID string `json:"campaign_id"`
+	ScheduleID string `json:"schedule_id"`
+}
+
+func (r *DeleteScheduledTriggerCampaignRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.CampaignID == "" {
+		return errors.New("campaign ID must not be empty")
+	}
+
+	if r.ScheduleID == "" {
+		return errors.New("schedule ID must not be empty")
+	}
+
+	return nil
+}
+
+// https://www.braze.com/docs/api/endpoints/messaging/schedule_messages/post_schedule_triggered_canvases/
+type ScheduleTriggerCanvasRequest struct {
+	CanvasID              string         `json:"canvas_id"`
+	CanvasEntryProperties map[string]any `json:"canvas_entry_properties,omitempty"`
+	Broadcast             *bool          `json:"broadcast,omitempty"`
+	Recipients            []*Recipient   `json:"recipients,omitempty"`
+	Schedule              *Schedule      `json:"schedule"`
+}
+
+func (r *ScheduleTriggerCanvasRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.CanvasID == "" {
+		return errors.New("canvas ID must not be empty")
+	}
+
+	if r.Schedule == nil {
+		return errors.New("schedule must not be nil")
+	}
+
+	return nil
+}
+
+type UpdateScheduledTriggerCanvasRequest struct {
+	CanvasID   string    `json:"canvas_id"`
+	ScheduleID string    `json:"schedule_id"`
+	Schedule   *Schedule `json:"schedule"`
+}
+
+func (r *UpdateScheduledTriggerCanvasRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.CanvasID == "" {
+		return errors.New("canvas ID must not be empty")
+	}
+
+	if r.ScheduleID == "" {
+		return errors.New("schedule ID must not be empty")
+	}
+
+	if r.Schedule == nil {
+		return errors.New("schedule must not be nil")
+	}
+
+	return nil
+}
+
+type DeleteScheduledTriggerCanvasRequest struct {
+	CanvasID   string `json:"canvas_id"`
+	ScheduleID string `json:"schedule_id"`
+}
+
+func (r *DeleteScheduledTriggerCanvasRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.CanvasID == "" {
+		return errors.New("canvas ID must not be empty")
+	}
+
+	if r.ScheduleID == "" {
+		return errors.New("schedule ID must not be empty")
+	}
+
+	return nil
+}
+
+// https://www.braze.com/docs/api/objects_filters/schedule_object/
+type Schedule struct {
+	// Time to send the message at. When InLocalTime is set, only the date and
+	// time of day are used and the message is delivered in each user's time zone.
+	Time time.Time `json:"time"`
+
+	InLocalTime   *bool `json:"in_local_time,omitempty"`
+	AtOptimalTime *bool `json:"at_optimal_time,omitempty"`
+}
+
+type ScheduleResponse struct {
+	Response
+	DispatchID string `json:"dispatch_id,omitempty"`
+	ScheduleID string `json:"schedule_id,omitempty"`
+}
@@ -0,0 +1,352 @@
+package braze_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessagingServiceScheduleTriggerCampaign(t *testing.T) {
+	srv, client := createTestServer(t, "/campaigns/trigger/schedule/create", func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"campaign_id":"c1","recipients":[{"external_user_id":"123"}],"schedule":{"time":"2023-05-24T21:30:00Z","in_local_time":true}}`, string(b))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"dispatch_id":"d1","schedule_id":"s1","message":"success"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Messaging().ScheduleTriggerCampaign(context.Background(), &braze.ScheduleTriggerCampaignRequest{
+		TriggerCampaignRequest: braze.TriggerCampaignRequest{
+			CampaignID: "c1",
+			Recipients: []*braze.Recipient{{ExternalUserID: braze.String("123")}},
+		},
+		Schedule: &braze.Schedule{
+			Time:        time.Date(2023, 5, 24, 21, 30, 0, 0, time.UTC),
+			InLocalTime: braze.Bool(true),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "success", resp.Message)
+	assert.Equal(t, "d1", resp.DispatchID)
+	assert.Equal(t, "s1", resp.ScheduleID)
+}
+
+func TestMessagingServiceDeleteScheduledTriggerCanvas(t *testing.T) {
+	srv, client := createTestServer(t, "/canvas/trigger/schedule/delete", func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"canvas_id":"c1","schedule_id":"s1"}`, string(b))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"message":"success"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Messaging().DeleteScheduledTriggerCanvas(context.Background(), &braze.DeleteScheduledTriggerCanvasRequest{
+		CanvasID:   "c1",
+		ScheduleID: "s1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "success", resp.Message)
+}
+
+func TestMessagingServiceScheduleTriggerCanvasMissingSchedule(t *testing.T) {
+	srv, client := createTestServer(t, "/canvas/trigger/schedule/create", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.Messaging().ScheduleTriggerCanvas(context.Background(), &braze.ScheduleTriggerCanvasRequest{
+		CanvasID:  "c1",
+		Broadcast: braze.Bool(true),
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestMessagesJSONRoundTrip(t *testing.T) {
+	tests := map[string]*braze.Messages{
+		"android push": {
+			AndroidPush: &braze.AndroidPushMessage{
+				Alert:    "alert",
+				Title:    "title",
+				Priority: braze.Int(1),
+				Buttons:  []*braze.AndroidPushActionButton{{Text: "open", URI: braze.String("https://foo")}},
+			},
+		},
+		"apple push": {
+			ApplePush: &braze.ApplePushMessage{
+				Badge:             braze.Int(1),
+				Alert:             &braze.ApplePushAlert{Body: "body", Title: braze.String("title")},
+				InterruptionLevel: &braze.ApplePushMessageInterruptionLevelTimeSensitive,
+				AssetFileType:     &braze.ApplePushMessageFileTypePNG,
+			},
+		},
+		"email": {
+			Email: &braze.EmailMessage{
+				AppID:       "app",
+				From:        "Diet Doctor <hello@dietdoctor.com>",
+				Subject:     braze.String("subject"),
+				Attachments: []*braze.EmailMessageAttachment{{FileName: "a.pdf", URL: "https://foo/a.pdf"}},
+			},
+		},
+		"sms": {
+			SMS: &braze.SMSMessage{
+				SubscriptionGroupID: "group",
+				Body:                "body",
+				AppID:               "app",
+			},
+		},
+		"mms": {
+			SMS: &braze.SMSMessage{
+				SubscriptionGroupID: "group",
+				Body:                "body",
+				AppID:               "app",
+				MediaItems:          []*braze.SMSMediaItem{{URL: "https://foo/a.png"}},
+			},
+		},
+		"webhook": {
+			Webhook: &braze.WebhookMessage{
+				URL:            "https://foo/hook",
+				RequestMethod:  braze.WebhookRequestMethodPost,
+				RequestHeaders: map[string]string{"X-Foo": "bar"},
+				Body:           braze.String(`{"foo":"bar"}`),
+			},
+		},
+		"content card": {
+			ContentCard: &braze.ContentCardMessage{
+				Type:     braze.ContentCardTypeCaptionedImage,
+				Message:  "message",
+				ImageURL: braze.String("https://foo/a.png"),
+				Pinned:   braze.Bool(true),
+				ExpireIn: braze.Int(3600),
+			},
+		},
+		"in-app message": {
+			InApp: &braze.InAppMessage{
+				Type:             braze.InAppMessageTypeModal,
+				Message:          "message",
+				ClickAction:      &braze.InAppMessageClickActionURI,
+				URI:              braze.String("https://foo"),
+				MessageClose:     &braze.InAppMessageCloseSwipe,
+				Header:           braze.String("header"),
+				TextAlignHeader:  &braze.InAppMessageTextAlignCenter,
+				ImageStyle:       &braze.InAppMessageImageStyleGraphic,
+				CropType:         &braze.InAppMessageCropTypeCenterCrop,
+				CloseButtonColor: braze.String("#FFFFFF"),
+				FrameColor:       braze.String("#000000"),
+				Buttons: []*braze.InAppMessageButton{{
+					Text:            "close",
+					ClickAction:     &braze.InAppMessageClickActionNone,
+					BackgroundColor: braze.String("#0073D1"),
+				}},
+			},
+		},
+		"slideup in-app message": {
+			InApp: &braze.InAppMessage{
+				Type:      braze.InAppMessageTypeSlideup,
+				Message:   "message",
+				SlideFrom: &braze.InAppMessageSlideFromBottom,
+				Duration:  braze.Int(5000),
+				Icon:      braze.String("f091"),
+			},
+		},
+		"kindle push": {
+			KindlePush: &braze.KindlePushMessage{
+				Alert:      "alert",
+				Title:      "title",
+				TimeToLive: braze.Int(60),
+			},
+		},
+		"huawei push": {
+			HuaweiPush: &braze.HuaweiPushMessage{
+				Alert:      "alert",
+				Title:      "title",
+				Category:   &braze.HuaweiPushCategoryHealth,
+				Importance: &braze.HuaweiPushImportanceNormal,
+			},
+		},
+		"web push": {
+			WebPush: &braze.WebPushMessage{
+				Alert:              "alert",
+				Title:              "title",
+				RequireInteraction: braze.Bool(true),
+				Buttons:            []*braze.WebPushActionButton{{Text: "open", Action: &braze.WebPushActionButtonActionOpenApp}},
+			},
+		},
+	}
+
+	for name, m := range tests {
+		t.Run(name, func(t *testing.T) {
+			b, err := json.Marshal(m)
+			require.NoError(t, err)
+
+			var got braze.Messages
+			require.NoError(t, json.Unmarshal(b, &got))
+			assert.Equal(t, m, &got)
+		})
+	}
+}
+
+func TestMessagesSMSJSON(t *testing.T) {
+	b, err := json.Marshal(&braze.SendMessagesRequest{
+		Messages: &braze.Messages{
+			SMS: &braze.SMSMessage{
+				SubscriptionGroupID: "group",
+				Body:                "body",
+				AppID:               "app",
+				MediaItems:          []*braze.SMSMediaItem{{URL: "https://foo/a.png"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"messages":{"sms":{"subscription_group_id":"group","body":"body","app_id":"app","media_items":[{"url":"https://foo/a.png"}]}}}`, string(b))
+}
+
+func TestMessagesInAppMessageJSON(t *testing.T) {
+	b, err := json.Marshal(&braze.Messages{
+		InApp: &braze.InAppMessage{
+			Type:             braze.InAppMessageTypeFull,
+			Message:          "message",
+			MessageClose:     &braze.InAppMessageCloseSwipe,
+			Orientation:      &braze.InAppMessageOrientationPortrait,
+			CloseButtonColor: braze.String("#FFFFFF"),
+			Buttons:          []*braze.InAppMessageButton{{Text: "ok", BorderColor: braze.String("#000000")}},
+		},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"in_app_message":{"type":"FULL","message":"message","message_close":"SWIPE","orientation":"PORTRAIT","close_btn_color":"#FFFFFF","buttons":[{"text":"ok","border_color":"#000000"}]}}`, string(b))
+}
+
+func TestMessagingServiceSendMessagesSMSWithoutSubscriptionGroup(t *testing.T) {
+	srv, client := createTestServer(t, "/messages/send", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.Messaging().SendMessages(context.Background(), &braze.SendMessagesRequest{
+		Messages: &braze.Messages{
+			SMS: &braze.SMSMessage{Body: "body", AppID: "app"},
+		},
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestMessagingServiceScheduleMessagesWebPush(t *testing.T) {
+	srv, client := createTestServer(t, "/messages/schedule/create", func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"messages":{"web_push":{"alert":"alert","title":"title","time_to_live":60}},"schedule":{"time":"2023-05-24T21:30:00Z"}}`, string(b))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"dispatch_id":"d1","schedule_id":"s1","message":"success"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Messaging().ScheduleMessages(context.Background(), &braze.ScheduleMessagesRequest{
+		SendMessagesRequest: braze.SendMessagesRequest{
+			Messages: &braze.Messages{
+				WebPush: &braze.WebPushMessage{
+					Alert:      "alert",
+					Title:      "title",
+					TimeToLive: braze.Int(60),
+				},
+			},
+		},
+		Schedule: &braze.Schedule{Time: time.Date(2023, 5, 24, 21, 30, 0, 0, time.UTC)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "s1", resp.ScheduleID)
+}
+
+func TestMessagingServiceSendMessagesWebPushInvalidTimeToLive(t *testing.T) {
+	srv, client := createTestServer(t, "/messages/send", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.Messaging().SendMessages(context.Background(), &braze.SendMessagesRequest{
+		Messages: &braze.Messages{
+			WebPush: &braze.WebPushMessage{
+				Alert:      "alert",
+				Title:      "title",
+				TimeToLive: braze.Int(2419201),
+			},
+		},
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestMessagingServiceSendMessagesSegment(t *testing.T) {
+	srv, client := createTestServer(t, "/messages/send", func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"segment_id":"s1","broadcast":true,"messages":{"sms":{"subscription_group_id":"group","body":"body","app_id":"app"}}}`, string(b))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"message":"success","dispatch_id":"d1"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Messaging().SendMessages(context.Background(), &braze.SendMessagesRequest{
+		SegmentID: braze.String("s1"),
+		Broadcast: braze.Bool(true),
+		Messages: &braze.Messages{
+			SMS: &braze.SMSMessage{SubscriptionGroupID: "group", Body: "body", AppID: "app"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "success", resp.Message)
+}
+
+func TestMessagingServiceUpdateLiveActivity(t *testing.T) {
+	srv, client := createTestServer(t, "/messages/live_activity/update", func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"app_id":"app","activity_id":"timer-1","content_state":{"remaining":300,"step":"Bake"},"end_activity":true,"dismissal_date":"2023-05-24T21:30:00Z","notification":{"alert":{"body":"Your bread is ready","title":"Timer"}}}`, string(b))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"message":"success"}`))
+	})
+	defer srv.Close()
+
+	dismissal := time.Date(2023, 5, 24, 21, 30, 0, 0, time.UTC)
+	resp, err := client.Messaging().UpdateLiveActivity(context.Background(), &braze.LiveActivityUpdateRequest{
+		AppID:         "app",
+		ActivityID:    "timer-1",
+		ContentState:  map[string]any{"remaining": 300, "step": "Bake"},
+		EndActivity:   braze.Bool(true),
+		DismissalDate: &dismissal,
+		Notification: &braze.LiveActivityNotification{
+			Alert: &braze.ApplePushAlert{Body: "Your bread is ready", Title: braze.String("Timer")},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "success", resp.Message)
+}
+
+func TestMessagingServiceStartLiveActivityMissingContentState(t *testing.T) {
+	srv, client := createTestServer(t, "/messages/live_activity/start", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.Messaging().StartLiveActivity(context.Background(), &braze.LiveActivityStartRequest{
+		AppID:                  "app",
+		ActivityID:             "timer-1",
+		ActivityAttributesType: "CookingTimerAttributes",
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
@@ -5,15 +5,44 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	preferenceCenterPath     = "/preference_center/v1"
+	preferenceCenterListPath = "/preference_center/v1/list"
+	preferenceCenterIDPath   = "/preference_center/v1/%s"
+	preferenceCenterURLPath  = "/preference_center/v1/%s/url"
+)
+
+var (
+	PreferenceCenterStateActive PreferenceCenterState = "active"
+	PreferenceCenterStateDraft  PreferenceCenterState = "draft"
 )
 
 type PreferenceCenterEndpoint interface {
 	CreateURL(ctx context.Context, req *PreferenceCenterCreateURLRequest) (*PreferenceCenterCreateURLResponse, error)
+	List(ctx context.Context) (*PreferenceCenterListResponse, error)
+	Details(ctx context.Context, preferenceCenterID string) (*PreferenceCenter, error)
+	Create(ctx context.Context, req *PreferenceCenterCreateRequest) (*PreferenceCenterResponse, error)
+	Update(ctx context.Context, req *PreferenceCenterUpdateRequest) (*PreferenceCenterResponse, error)
 }
 
+type PreferenceCenterState string
+
+// https://www.braze.com/docs/api/endpoints/preference_center/get_create_url_preference_center/
+//
+// The user is identified by exactly one of UserID, ExternalID or Email.
 type PreferenceCenterCreateURLRequest struct {
 	PreferenceCenterID string
-	UserID             string
+
+	// Braze ID of the user, passed in the path.
+	UserID string
+
+	// External ID or email address of the user, passed as query parameters.
+	ExternalID string
+	Email      string
 }
 
 func (r *PreferenceCenterCreateURLRequest) validate() error {
@@ -25,17 +54,135 @@ func (r *PreferenceCenterCreateURLRequest) validate() error {
 		return errors.New("preferences center ID must not be empty")
 	}
 
-	if r.UserID == "" {
-		return errors.New("user ID must not be empty")
+	n := 0
+	for _, id := range []string{r.UserID, r.ExternalID, r.Email} {
+		if id != "" {
+			n++
+		}
+	}
+	if n != 1 {
+		return errors.New("exactly one of user ID, external ID or email must be set")
 	}
 
 	return nil
 }
 
+func (r *PreferenceCenterCreateURLRequest) path() string {
+	path := fmt.Sprintf(preferenceCenterURLPath, url.PathEscape(r.PreferenceCenterID))
+	if r.UserID != "" {
+		path += "/" + url.PathEscape(r.UserID)
+	}
+	return path
+}
+
+func (r *PreferenceCenterCreateURLRequest) values() url.Values {
+	v := url.Values{}
+	if r.ExternalID != "" {
+		v.Set("external_id", r.ExternalID)
+	}
+	if r.Email != "" {
+		v.Set("email", r.Email)
+	}
+	return v
+}
+
 type PreferenceCenterCreateURLResponse struct {
 	URL string
 }
 
+// https://www.braze.com/docs/api/endpoints/preference_center/get_list_preference_center/
+type PreferenceCenterListResponse struct {
+	Message           string                     `json:"message,omitempty"`
+	PreferenceCenters []*PreferenceCenterSummary `json:"preference_centers,omitempty"`
+}
+
+type PreferenceCenterSummary struct {
+	Name                  string    `json:"name"`
+	PreferenceCenterAPIID string    `json:"preference_center_api_id"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+// https://www.braze.com/docs/api/endpoints/preference_center/get_view_details_preference_center/
+type PreferenceCenter struct {
+	Name                     string                   `json:"name"`
+	PreferenceCenterAPIID    string                   `json:"preference_center_api_id"`
+	CreatedAt                time.Time                `json:"created_at"`
+	UpdatedAt                time.Time                `json:"updated_at"`
+	PreferenceCenterTitle    string                   `json:"preference_center_title"`
+	PreferenceCenterPageHTML string                   `json:"preference_center_page_html"`
+	ConfirmationPageHTML     string                   `json:"confirmation_page_html"`
+	State                    PreferenceCenterState    `json:"state"`
+	Options                  *PreferenceCenterOptions `json:"preference_center_options,omitempty"`
+}
+
+type PreferenceCenterOptions struct {
+	// Content of the viewport meta tag of the preference center page.
+	MetaViewportContent *string `json:"meta-viewport-content,omitempty"`
+}
+
+// https://www.braze.com/docs/api/endpoints/preference_center/post_create_preference_center/
+type PreferenceCenterCreateRequest struct {
+	Name                     string                   `json:"name"`
+	PreferenceCenterTitle    *string                  `json:"preference_center_title,omitempty"`
+	PreferenceCenterPageHTML string                   `json:"preference_center_page_html"`
+	ConfirmationPageHTML     string                   `json:"confirmation_page_html"`
+	State                    *PreferenceCenterState   `json:"state,omitempty"`
+	Options                  *PreferenceCenterOptions `json:"options,omitempty"`
+}
+
+func (r *PreferenceCenterCreateRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.Name == "" {
+		return errors.New("name must not be empty")
+	}
+
+	if r.PreferenceCenterPageHTML == "" {
+		return errors.New("preference center page HTML must not be empty")
+	}
+
+	if r.ConfirmationPageHTML == "" {
+		return errors.New("confirmation page HTML must not be empty")
+	}
+
+	return nil
+}
+
+// https://www.braze.com/docs/api/endpoints/preference_center/put_update_preference_center/
+//
+// Only the set fields are updated.
+type PreferenceCenterUpdateRequest struct {
+	PreferenceCenterID       string                   `json:"-"`
+	PreferenceCenterTitle    *string                  `json:"preference_center_title,omitempty"`
+	PreferenceCenterPageHTML *string                  `json:"preference_center_page_html,omitempty"`
+	ConfirmationPageHTML     *string                  `json:"confirmation_page_html,omitempty"`
+	State                    *PreferenceCenterState   `json:"state,omitempty"`
+	Options                  *PreferenceCenterOptions `json:"options,omitempty"`
+}
+
+func (r *PreferenceCenterUpdateRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.PreferenceCenterID == "" {
+		return errors.New("preferences center ID must not be empty")
+	}
+
+	return nil
+}
+
+type PreferenceCenterResponse struct {
+	Message               string    `json:"message,omitempty"`
+	PreferenceCenterAPIID string    `json:"preference_center_api_id,omitempty"`
+	LiquidTag             string    `json:"liquid_tag,omitempty"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
 type PreferenceCenterService struct {
 	client *Client
 }
@@ -45,9 +192,7 @@ func (s *PreferenceCenterService) CreateURL(ctx context.Context, r *PreferenceCe
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/preference_center/v1/%s/url/%s", r.PreferenceCenterID, r.UserID)
-
-	req, err := s.client.http.newRequest(http.MethodPost, path, r)
+	req, err := s.client.http.newQueryRequest(http.MethodGet, r.path(), r.values())
 	if err != nil {
 		return nil, err
 	}
@@ -64,3 +209,71 @@ func (s *PreferenceCenterService) CreateURL(ctx context.Context, r *PreferenceCe
 		URL: resp.URL,
 	}, nil
 }
+
+func (s *PreferenceCenterService) List(ctx context.Context) (*PreferenceCenterListResponse, error) {
+	req, err := s.client.http.newRequest(http.MethodGet, preferenceCenterListPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res PreferenceCenterListResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *PreferenceCenterService) Details(ctx context.Context, preferenceCenterID string) (*PreferenceCenter, error) {
+	if preferenceCenterID == "" {
+		return nil, errors.New("preferences center ID must not be empty")
+	}
+
+	req, err := s.client.http.newRequest(http.MethodGet, fmt.Sprintf(preferenceCenterIDPath, url.PathEscape(preferenceCenterID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res PreferenceCenter
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *PreferenceCenterService) Create(ctx context.Context, r *PreferenceCenterCreateRequest) (*PreferenceCenterResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, preferenceCenterPath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res PreferenceCenterResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *PreferenceCenterService) Update(ctx context.Context, r *PreferenceCenterUpdateRequest) (*PreferenceCenterResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPut, fmt.Sprintf(preferenceCenterIDPath, url.PathEscape(r.PreferenceCenterID)), r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res PreferenceCenterResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -2,6 +2,7 @@ package braze_test
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -9,13 +10,15 @@ import (
 
 	"github.com/dietdoctor/go-braze"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPreferencesServerCreateURL(t *testing.T) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/preference_center/v1/foo/url/bar", func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
-		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Empty(t, r.Header.Get("Content-Type"))
 
 		w.WriteHeader(http.StatusCreated)
 		w.Write([]byte(`{"preference_center_url":"https://foo"}`))
@@ -41,7 +44,6 @@ func TestPreferencesServerCreateURLInternalServerError(t *testing.T) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/preference_center/v1/foo/url/bar", func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
-		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
 		w.WriteHeader(http.StatusInternalServerError)
 	})
 
@@ -56,3 +58,101 @@ func TestPreferencesServerCreateURLInternalServerError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 }
+
+func TestPreferencesServerCreateURLEmail(t *testing.T) {
+	srv, client := createTestServer(t, "/preference_center/v1/foo/url", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "foo@dietdoctor.com", r.URL.Query().Get("email"))
+		assert.Empty(t, r.URL.Query().Get("external_id"))
+
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Empty(t, b)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"preference_center_url":"https://foo"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.PreferenceCenter().CreateURL(context.Background(), &braze.PreferenceCenterCreateURLRequest{
+		PreferenceCenterID: "foo",
+		Email:              "foo@dietdoctor.com",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://foo", resp.URL)
+}
+
+func TestPreferencesServerCreateURLMultipleIdentifiers(t *testing.T) {
+	srv, client := createTestServer(t, "/preference_center/v1/foo/url", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.PreferenceCenter().CreateURL(context.Background(), &braze.PreferenceCenterCreateURLRequest{
+		PreferenceCenterID: "foo",
+		ExternalID:         "123",
+		Email:              "foo@dietdoctor.com",
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestPreferencesServerCreate(t *testing.T) {
+	srv, client := createTestServer(t, "/preference_center/v1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"newsletters","preference_center_page_html":"<html/>","confirmation_page_html":"<p>Saved</p>","state":"draft","options":{"meta-viewport-content":"width=device-width"}}`, string(b))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"message":"success","preference_center_api_id":"pc1","liquid_tag":"{{preference_center.${newsletters}}}","created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.PreferenceCenter().Create(context.Background(), &braze.PreferenceCenterCreateRequest{
+		Name:                     "newsletters",
+		PreferenceCenterPageHTML: "<html/>",
+		ConfirmationPageHTML:     "<p>Saved</p>",
+		State:                    &braze.PreferenceCenterStateDraft,
+		Options: &braze.PreferenceCenterOptions{
+			MetaViewportContent: braze.String("width=device-width"),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "pc1", resp.PreferenceCenterAPIID)
+}
+
+func TestPreferencesServerUpdate(t *testing.T) {
+	srv, client := createTestServer(t, "/preference_center/v1/pc1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"state":"active"}`, string(b))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","preference_center_api_id":"pc1","created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-02T00:00:00Z"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.PreferenceCenter().Update(context.Background(), &braze.PreferenceCenterUpdateRequest{
+		PreferenceCenterID: "pc1",
+		State:              &braze.PreferenceCenterStateActive,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "success", resp.Message)
+}
+
+func TestPreferencesServerDetails(t *testing.T) {
+	srv, client := createTestServer(t, "/preference_center/v1/pc1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name":"newsletters","preference_center_api_id":"pc1","created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-02T00:00:00Z","preference_center_title":"Newsletters","preference_center_page_html":"<html/>","confirmation_page_html":"<p>Saved</p>","state":"active","preference_center_options":{"meta-viewport-content":"width=device-width"}}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.PreferenceCenter().Details(context.Background(), "pc1")
+	require.NoError(t, err)
+	assert.Equal(t, braze.PreferenceCenterStateActive, resp.State)
+	assert.Equal(t, "width=device-width", *resp.Options.MetaViewportContent)
+}
@@ -0,0 +1,143 @@
+package braze
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	purchasesProductListPath    = "/purchases/product_list"
+	purchasesQuantitySeriesPath = "/purchases/quantity_series"
+	purchasesRevenueSeriesPath  = "/purchases/revenue_series"
+
+	purchasesProductListPageSize = 250
+)
+
+type PurchasesEndpoint interface {
+	// ProductList returns a page of product IDs, starting at page 0.
+	ProductList(ctx context.Context, page int) (*ProductListResponse, error)
+	QuantitySeries(ctx context.Context, r *PurchaseSeriesRequest) (*TimeSeries, error)
+	RevenueSeries(ctx context.Context, r *PurchaseSeriesRequest) (*TimeSeries, error)
+
+	// Products returns an iterator over all product IDs.
+	Products() *Iterator[string]
+}
+
+var _ PurchasesEndpoint = (*PurchasesService)(nil)
+
+type PurchasesService struct {
+	client *Client
+}
+
+// https://www.braze.com/docs/api/endpoints/export/purchases/get_list_product_id/
+type ProductListResponse struct {
+	Message  string   `json:"message,omitempty"`
+	Products []string `json:"products,omitempty"`
+}
+
+// https://www.braze.com/docs/api/endpoints/export/purchases/get_number_of_purchases/
+// https://www.braze.com/docs/api/endpoints/export/purchases/get_revenue_series/
+type PurchaseSeriesRequest struct {
+	// Restrict the series to a single product. Defaults to all products.
+	Product *string
+
+	// Max number of units before EndingAt to include in the returned series.
+	// Must be between 1 and 100 (inclusive), or 24 when Unit is hour.
+	Length int
+
+	// Either day or hour. Defaults to day.
+	Unit *TimeSeriesUnit
+
+	// Time on which the data series should end. Defaults to time of the request.
+	EndingAt *time.Time
+
+	// Restrict the series to a single app. Defaults to all apps.
+	AppID *string
+}
+
+func (r *PurchaseSeriesRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	return r.timeSeries().validate()
+}
+
+func (r *PurchaseSeriesRequest) values() url.Values {
+	v := r.timeSeries().values()
+	if r.Product != nil {
+		v.Set("product", *r.Product)
+	}
+	return v
+}
+
+func (r *PurchaseSeriesRequest) timeSeries() *TimeSeriesRequest {
+	return &TimeSeriesRequest{
+		Length:   r.Length,
+		Unit:     r.Unit,
+		EndingAt: r.EndingAt,
+		AppID:    r.AppID,
+	}
+}
+
+func (s *PurchasesService) ProductList(ctx context.Context, page int) (*ProductListResponse, error) {
+	query := url.Values{}
+	if page != 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, purchasesProductListPath, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ProductListResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *PurchasesService) QuantitySeries(ctx context.Context, r *PurchaseSeriesRequest) (*TimeSeries, error) {
+	return s.series(ctx, purchasesQuantitySeriesPath, "purchase_quantity", r)
+}
+
+func (s *PurchasesService) RevenueSeries(ctx context.Context, r *PurchaseSeriesRequest) (*TimeSeries, error) {
+	return s.series(ctx, purchasesRevenueSeriesPath, "revenue", r)
+}
+
+func (s *PurchasesService) series(ctx context.Context, path, metric string, r *PurchaseSeriesRequest) (*TimeSeries, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, path, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res timeSeriesResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return res.timeSeries(metric)
+}
+
+func (s *PurchasesService) Products() *Iterator[string] {
+	var page int
+	return newIterator(func(ctx context.Context) ([]string, bool, error) {
+		res, err := s.ProductList(ctx, page)
+		if err != nil {
+			return nil, false, err
+		}
+		page++
+
+		return res.Products, len(res.Products) == purchasesProductListPageSize, nil
+	})
+}
@@ -0,0 +1,80 @@
+package braze_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurchasesServiceRevenueSeries(t *testing.T) {
+	srv, client := createTestServer(t, "/purchases/revenue_series", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "membership", r.URL.Query().Get("product"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","data":[{"time":"2023-01-01","revenue":199.5}]}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Purchases().RevenueSeries(context.Background(), &braze.PurchaseSeriesRequest{
+		Product: braze.String("membership"),
+		Length:  1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*braze.TimeSeriesEntry{{Time: "2023-01-01", Value: 199.5}}, resp.Data)
+}
+
+func TestPurchasesServiceQuantitySeries(t *testing.T) {
+	srv, client := createTestServer(t, "/purchases/quantity_series", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "7", q.Get("length"))
+		assert.Equal(t, "day", q.Get("unit"))
+		assert.Equal(t, "app1", q.Get("app_id"))
+		assert.Empty(t, q.Get("product"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","data":[{"time":"2023-01-01","purchase_quantity":12}]}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Purchases().QuantitySeries(context.Background(), &braze.PurchaseSeriesRequest{
+		Length: 7,
+		Unit:   &braze.TimeSeriesUnitDay,
+		AppID:  braze.String("app1"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*braze.TimeSeriesEntry{{Time: "2023-01-01", Value: 12}}, resp.Data)
+}
+
+func TestPurchasesServiceProducts(t *testing.T) {
+	srv, client := createTestServer(t, "/purchases/product_list", func(w http.ResponseWriter, r *http.Request) {
+		n := 250
+		if r.URL.Query().Get("page") == "1" {
+			n = 3
+		}
+
+		products := make([]string, n)
+		for i := range products {
+			products[i] = fmt.Sprintf(`"product%d"`, i)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"message":"success","products":[%s]}`, strings.Join(products, ","))
+	})
+	defer srv.Close()
+
+	var products []string
+	it := client.Purchases().Products()
+	for it.Next(context.Background()) {
+		products = append(products, it.Value())
+	}
+	require.NoError(t, it.Err())
+	assert.Len(t, products, 253)
+	assert.Equal(t, "product0", products[0])
+	assert.Equal(t, "product2", products[252])
+}
@@ -0,0 +1,197 @@
+package braze
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	segmentsListPath       = "/segments/list"
+	segmentsDetailsPath    = "/segments/details"
+	segmentsDataSeriesPath = "/segments/data_series"
+
+	segmentsListPageSize = 100
+)
+
+type SegmentsEndpoint interface {
+	List(ctx context.Context, r *SegmentListRequest) (*SegmentListResponse, error)
+	Details(ctx context.Context, segmentID string) (*SegmentDetails, error)
+	DataSeries(ctx context.Context, r *SegmentDataSeriesRequest) (*SegmentDataSeriesResponse, error)
+
+	// Segments returns an iterator over all segments, starting at the page of
+	// the request.
+	Segments(r *SegmentListRequest) *Iterator[*SegmentSummary]
+}
+
+var _ SegmentsEndpoint = (*SegmentsService)(nil)
+
+type SegmentsService struct {
+	client *Client
+}
+
+// https://www.braze.com/docs/api/endpoints/export/segments/get_segment/
+type SegmentListRequest struct {
+	// Page of segments to return, starting at 0. Each page has up to 100
+	// segments.
+	Page          int
+	SortDirection *SortDirection
+}
+
+func (r *SegmentListRequest) values() url.Values {
+	v := url.Values{}
+	if r.Page != 0 {
+		v.Set("page", strconv.Itoa(r.Page))
+	}
+	if r.SortDirection != nil {
+		v.Set("sort_direction", string(*r.SortDirection))
+	}
+	return v
+}
+
+type SegmentListResponse struct {
+	Message  string            `json:"message,omitempty"`
+	Segments []*SegmentSummary `json:"segments,omitempty"`
+}
+
+type SegmentSummary struct {
+	ID                       string   `json:"id"`
+	Name                     string   `json:"name"`
+	AnalyticsTrackingEnabled bool     `json:"analytics_tracking_enabled"`
+	Tags                     []string `json:"tags,omitempty"`
+}
+
+// https://www.braze.com/docs/api/endpoints/export/segments/get_segment_details/
+type SegmentDetails struct {
+	Message         string    `json:"message,omitempty"`
+	Name            string    `json:"name"`
+	Description     string    `json:"description,omitempty"`
+	TextDescription string    `json:"text_description,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	Tags            []string  `json:"tags,omitempty"`
+	Teams           []string  `json:"teams,omitempty"`
+}
+
+// https://www.braze.com/docs/api/endpoints/export/segments/get_segment_analytics/
+type SegmentDataSeriesRequest struct {
+	SegmentID string
+
+	// Max number of days before EndingAt to include in the returned series.
+	// Must be between 1 and 100 (inclusive).
+	Length int
+
+	// Date on which the data series should end. Defaults to time of the request.
+	EndingAt *time.Time
+}
+
+func (r *SegmentDataSeriesRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.SegmentID == "" {
+		return errors.New("segment ID must not be empty")
+	}
+
+	if r.Length < 1 || r.Length > 100 {
+		return errors.New("length must be between 1 and 100")
+	}
+
+	return nil
+}
+
+func (r *SegmentDataSeriesRequest) values() url.Values {
+	v := url.Values{}
+	v.Set("segment_id", r.SegmentID)
+	v.Set("length", strconv.Itoa(r.Length))
+	if r.EndingAt != nil {
+		v.Set("ending_at", r.EndingAt.Format(time.RFC3339))
+	}
+	return v
+}
+
+type SegmentDataSeriesResponse struct {
+	Message string                    `json:"message,omitempty"`
+	Data    []*SegmentDataSeriesEntry `json:"data,omitempty"`
+}
+
+type SegmentDataSeriesEntry struct {
+	// Date of the entry in yyyy-MM-dd format.
+	Time string `json:"time"`
+	Size int    `json:"size"`
+}
+
+func (s *SegmentsService) List(ctx context.Context, r *SegmentListRequest) (*SegmentListResponse, error) {
+	if r == nil {
+		return nil, errors.New("request must not be nil")
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, segmentsListPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res SegmentListResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *SegmentsService) Details(ctx context.Context, segmentID string) (*SegmentDetails, error) {
+	if segmentID == "" {
+		return nil, errors.New("segment ID must not be empty")
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, segmentsDetailsPath, url.Values{"segment_id": {segmentID}})
+	if err != nil {
+		return nil, err
+	}
+
+	var res SegmentDetails
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *SegmentsService) DataSeries(ctx context.Context, r *SegmentDataSeriesRequest) (*SegmentDataSeriesResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, segmentsDataSeriesPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res SegmentDataSeriesResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *SegmentsService) Segments(r *SegmentListRequest) *Iterator[*SegmentSummary] {
+	page := SegmentListRequest{}
+	if r != nil {
+		page = *r
+	}
+
+	return newIterator(func(ctx context.Context) ([]*SegmentSummary, bool, error) {
+		res, err := s.List(ctx, &page)
+		if err != nil {
+			return nil, false, err
+		}
+		page.Page++
+
+		return res.Segments, len(res.Segments) == segmentsListPageSize, nil
+	})
+}
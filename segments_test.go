@@ -0,0 +1,51 @@
+package braze_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSegmentsServiceSegmentsIterator(t *testing.T) {
+	srv, client := createTestServer(t, "/segments/list", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.URL.Query().Get("page"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","segments":[{"id":"s1","name":"Keto","analytics_tracking_enabled":true},{"id":"s2","name":"Vegan"}]}`))
+	})
+	defer srv.Close()
+
+	it := client.Segments().Segments(nil)
+
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().ID)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"s1", "s2"}, ids)
+}
+
+func TestSegmentsServiceDataSeries(t *testing.T) {
+	srv, client := createTestServer(t, "/segments/data_series", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "s1", r.URL.Query().Get("segment_id"))
+		assert.Equal(t, "2", r.URL.Query().Get("length"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","data":[{"time":"2023-01-01","size":100},{"time":"2023-01-02","size":105}]}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Segments().DataSeries(context.Background(), &braze.SegmentDataSeriesRequest{
+		SegmentID: "s1",
+		Length:    2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*braze.SegmentDataSeriesEntry{
+		{Time: "2023-01-01", Size: 100},
+		{Time: "2023-01-02", Size: 105},
+	}, resp.Data)
+}
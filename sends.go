@@ -0,0 +1,133 @@
+package braze
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	sendsIDCreatePath   = "/sends/id/create"
+	sendsDataSeriesPath = "/sends/data_series"
+)
+
+type SendsEndpoint interface {
+	CreateSendID(ctx context.Context, campaignID, sendID string) (*Response, error)
+	DataSeries(ctx context.Context, r *SendDataSeriesRequest) (*SendDataSeriesResponse, error)
+}
+
+var _ SendsEndpoint = (*SendsService)(nil)
+
+type SendsService struct {
+	client *Client
+}
+
+// CreateSendID registers a send identifier for the campaign. The returned
+// identifier can be passed as TriggerCampaignRequest.SendID.
+func (s *SendsService) CreateSendID(ctx context.Context, campaignID, sendID string) (*Response, error) {
+	if campaignID == "" {
+		return nil, errors.New("campaign ID must not be empty")
+	}
+
+	body := struct {
+		CampaignID string `json:"campaign_id"`
+		SendID     string `json:"send_id,omitempty"`
+	}{
+		CampaignID: campaignID,
+		SendID:     sendID,
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, sendsIDCreatePath, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *SendsService) DataSeries(ctx context.Context, r *SendDataSeriesRequest) (*SendDataSeriesResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, sendsDataSeriesPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res SendDataSeriesResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// https://www.braze.com/docs/api/endpoints/export/campaigns/get_send_analytics/
+type SendDataSeriesRequest struct {
+	CampaignID string
+	SendID     string
+
+	// Max number of days before EndingAt to include in the returned series.
+	// Must be between 1 and 100 (inclusive).
+	Length int
+
+	// Date on which the data series should end. Defaults to time of the request.
+	EndingAt *time.Time
+}
+
+func (r *SendDataSeriesRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.CampaignID == "" {
+		return errors.New("campaign ID must not be empty")
+	}
+
+	if r.SendID == "" {
+		return errors.New("send ID must not be empty")
+	}
+
+	if r.Length < 1 || r.Length > 100 {
+		return errors.New("length must be between 1 and 100")
+	}
+
+	return nil
+}
+
+func (r *SendDataSeriesRequest) values() url.Values {
+	v := url.Values{}
+	v.Set("campaign_id", r.CampaignID)
+	v.Set("send_id", r.SendID)
+	v.Set("length", strconv.Itoa(r.Length))
+	if r.EndingAt != nil {
+		v.Set("ending_at", r.EndingAt.Format(time.RFC3339))
+	}
+	return v
+}
+
+type SendDataSeriesResponse struct {
+	Message string                 `json:"message,omitempty"`
+	Data    []*SendDataSeriesEntry `json:"data,omitempty"`
+}
+
+type SendDataSeriesEntry struct {
+	// Date of the entry in yyyy-MM-dd format.
+	Time string `json:"time"`
+
+	Messages *ChannelStatistics `json:"messages,omitempty"`
+
+	ConversionsBySendTime int     `json:"conversions_by_send_time"`
+	Conversions           int     `json:"conversions"`
+	UniqueRecipients      int     `json:"unique_recipients"`
+	Revenue               float64 `json:"revenue"`
+}
@@ -0,0 +1,51 @@
+package braze_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendsServiceCreateSendID(t *testing.T) {
+	srv, client := createTestServer(t, "/sends/id/create", func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"campaign_id":"c1","send_id":"s1"}`, string(b))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"message":"success","send_id":"s1"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Sends().CreateSendID(context.Background(), "c1", "s1")
+	require.NoError(t, err)
+	assert.Equal(t, "s1", resp.SendID)
+}
+
+func TestSendsServiceDataSeries(t *testing.T) {
+	srv, client := createTestServer(t, "/sends/data_series", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "c1", r.URL.Query().Get("campaign_id"))
+		assert.Equal(t, "s1", r.URL.Query().Get("send_id"))
+		assert.Equal(t, "7", r.URL.Query().Get("length"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","data":[{"time":"2023-01-01","messages":{"email":[{"variation_name":"A","sent":10,"delivered":9}]},"conversions":2,"revenue":1.5}]}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Sends().DataSeries(context.Background(), &braze.SendDataSeriesRequest{
+		CampaignID: "c1",
+		SendID:     "s1",
+		Length:     7,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, 2, resp.Data[0].Conversions)
+	assert.Equal(t, 9, resp.Data[0].Messages.Email[0].Delivered)
+}
@@ -0,0 +1,44 @@
+package braze
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+const sessionsDataSeriesPath = "/sessions/data_series"
+
+type SessionsEndpoint interface {
+	DataSeries(ctx context.Context, r *TimeSeriesRequest) (*TimeSeries, error)
+}
+
+var _ SessionsEndpoint = (*SessionsService)(nil)
+
+type SessionsService struct {
+	client *Client
+}
+
+// DataSeries returns the number of sessions per unit of time.
+//
+// https://www.braze.com/docs/api/endpoints/export/sessions/get_sessions_analytics/
+func (s *SessionsService) DataSeries(ctx context.Context, r *TimeSeriesRequest) (*TimeSeries, error) {
+	if r == nil {
+		return nil, errors.New("request must not be nil")
+	}
+
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, sessionsDataSeriesPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res timeSeriesResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return res.timeSeries("sessions")
+}
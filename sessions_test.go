@@ -0,0 +1,56 @@
+package braze_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionsServiceDataSeries(t *testing.T) {
+	srv, client := createTestServer(t, "/sessions/data_series", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "2", q.Get("length"))
+		assert.Equal(t, "day", q.Get("unit"))
+		assert.Equal(t, "2023-01-02T00:00:00Z", q.Get("ending_at"))
+		assert.Equal(t, "app", q.Get("app_id"))
+		assert.Equal(t, "s1", q.Get("segment_id"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","data":[{"time":"2023-01-01","sessions":500},{"time":"2023-01-02","sessions":520}]}`))
+	})
+	defer srv.Close()
+
+	end := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	resp, err := client.Sessions().DataSeries(context.Background(), &braze.TimeSeriesRequest{
+		Length:    2,
+		Unit:      &braze.TimeSeriesUnitDay,
+		EndingAt:  &end,
+		AppID:     braze.String("app"),
+		SegmentID: braze.String("s1"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*braze.TimeSeriesEntry{
+		{Time: "2023-01-01", Value: 500},
+		{Time: "2023-01-02", Value: 520},
+	}, resp.Data)
+}
+
+func TestSessionsServiceDataSeriesInvalidUnit(t *testing.T) {
+	srv, client := createTestServer(t, "/sessions/data_series", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	unit := braze.TimeSeriesUnit("minute")
+	resp, err := client.Sessions().DataSeries(context.Background(), &braze.TimeSeriesRequest{
+		Length: 2,
+		Unit:   &unit,
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
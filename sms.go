@@ -0,0 +1,147 @@
+package braze
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	smsInvalidPhoneNumbersPath       = "/sms/invalid_phone_numbers"
+	smsInvalidPhoneNumbersRemovePath = "/sms/invalid_phone_numbers/remove"
+
+	smsQueryMaxLimit = 500
+
+	// Max number of phone numbers Braze accepts in a single removal request.
+	smsRemoveMaxSize = 50
+)
+
+type SMSEndpoint interface {
+	InvalidPhoneNumbers(ctx context.Context, r *InvalidPhoneNumbersRequest) (*InvalidPhoneNumbersResponse, error)
+	RemoveInvalidPhoneNumbers(ctx context.Context, phoneNumbers []string) (*Response, error)
+}
+
+var _ SMSEndpoint = (*SMSService)(nil)
+
+type SMSService struct {
+	client *Client
+}
+
+// https://www.braze.com/docs/api/endpoints/sms/get_query_invalid_numbers/
+//
+// Invalid phone numbers are queried either by date range or by phone numbers.
+type InvalidPhoneNumbersRequest struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+
+	// Max number of results to return, up to 500. Defaults to 100.
+	Limit  int
+	Offset int
+
+	// Phone numbers in E.164 format.
+	PhoneNumbers []string
+}
+
+func (r *InvalidPhoneNumbersRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if len(r.PhoneNumbers) == 0 && (r.StartDate == nil || r.EndDate == nil) {
+		return errors.New("either phone numbers or start and end dates must be set")
+	}
+
+	if r.Limit < 0 || r.Limit > smsQueryMaxLimit {
+		return errors.New("limit must be between 0 and 500")
+	}
+
+	if r.Offset < 0 {
+		return errors.New("offset must not be negative")
+	}
+
+	return nil
+}
+
+func (r *InvalidPhoneNumbersRequest) values() url.Values {
+	v := url.Values{}
+	if r.StartDate != nil {
+		v.Set("start_date", r.StartDate.Format(dateFormat))
+	}
+	if r.EndDate != nil {
+		v.Set("end_date", r.EndDate.Format(dateFormat))
+	}
+	if r.Limit != 0 {
+		v.Set("limit", strconv.Itoa(r.Limit))
+	}
+	if r.Offset != 0 {
+		v.Set("offset", strconv.Itoa(r.Offset))
+	}
+	for _, p := range r.PhoneNumbers {
+		v.Add("phone_numbers[]", p)
+	}
+	return v
+}
+
+type InvalidPhoneNumbersResponse struct {
+	Message string                `json:"message,omitempty"`
+	SMS     []*InvalidPhoneNumber `json:"sms,omitempty"`
+}
+
+type InvalidPhoneNumber struct {
+	Phone             string    `json:"phone"`
+	InvalidDetectedAt time.Time `json:"invalid_detected_at"`
+}
+
+func (s *SMSService) InvalidPhoneNumbers(ctx context.Context, r *InvalidPhoneNumbersRequest) (*InvalidPhoneNumbersResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, smsInvalidPhoneNumbersPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res InvalidPhoneNumbersResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// RemoveInvalidPhoneNumbers removes up to 50 phone numbers, in E.164 format,
+// from the invalid list.
+//
+// https://www.braze.com/docs/api/endpoints/sms/post_remove_invalid_numbers/
+func (s *SMSService) RemoveInvalidPhoneNumbers(ctx context.Context, phoneNumbers []string) (*Response, error) {
+	if len(phoneNumbers) == 0 {
+		return nil, errors.New("phone numbers must not be empty")
+	}
+
+	if len(phoneNumbers) > smsRemoveMaxSize {
+		return nil, fmt.Errorf("at most %d phone numbers can be removed in a single request", smsRemoveMaxSize)
+	}
+
+	body := struct {
+		PhoneNumbers []string `json:"phone_numbers"`
+	}{
+		PhoneNumbers: phoneNumbers,
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, smsInvalidPhoneNumbersRemovePath, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
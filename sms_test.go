@@ -0,0 +1,54 @@
+package braze_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMSServiceInvalidPhoneNumbers(t *testing.T) {
+	srv, client := createTestServer(t, "/sms/invalid_phone_numbers", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "end_date=2023-01-31&limit=100&offset=200&start_date=2023-01-01", r.URL.RawQuery)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","sms":[{"phone":"+46701234567","invalid_detected_at":"2023-01-10T11:45:12Z"}]}`))
+	})
+	defer srv.Close()
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+	resp, err := client.SMS().InvalidPhoneNumbers(context.Background(), &braze.InvalidPhoneNumbersRequest{
+		StartDate: &start,
+		EndDate:   &end,
+		Limit:     100,
+		Offset:    200,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*braze.InvalidPhoneNumber{{
+		Phone:             "+46701234567",
+		InvalidDetectedAt: time.Date(2023, 1, 10, 11, 45, 12, 0, time.UTC),
+	}}, resp.SMS)
+}
+
+func TestSMSServiceRemoveInvalidPhoneNumbers(t *testing.T) {
+	srv, client := createTestServer(t, "/sms/invalid_phone_numbers/remove", func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"phone_numbers":["+46701234567"]}`, string(b))
+
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"message":"success"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.SMS().RemoveInvalidPhoneNumbers(context.Background(), []string{"+46701234567"})
+	require.NoError(t, err)
+	assert.Equal(t, "success", resp.Message)
+}
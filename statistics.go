@@ -0,0 +1,89 @@
+package braze
+
+// ChannelStatistics holds per-variation statistics of every messaging channel.
+type ChannelStatistics struct {
+	IOSPush             []*PushStatistics         `json:"ios_push,omitempty"`
+	AndroidPush         []*PushStatistics         `json:"android_push,omitempty"`
+	KindlePush          []*PushStatistics         `json:"kindle_push,omitempty"`
+	WebPush             []*PushStatistics         `json:"web_push,omitempty"`
+	Email               []*EmailStatistics        `json:"email,omitempty"`
+	SMS                 []*SMSStatistics          `json:"sms,omitempty"`
+	Webhook             []*WebhookStatistics      `json:"webhook,omitempty"`
+	ContentCards        []*ContentCardStatistics  `json:"content_cards,omitempty"`
+	InAppMessage        []*InAppMessageStatistics `json:"in_app_message,omitempty"`
+	TriggerInAppMessage []*InAppMessageStatistics `json:"trigger_in_app_message,omitempty"`
+}
+
+// VariationStatistics holds the statistics shared by all channels.
+type VariationStatistics struct {
+	VariationName         string  `json:"variation_name,omitempty"`
+	VariationAPIID        string  `json:"variation_api_id,omitempty"`
+	Revenue               float64 `json:"revenue"`
+	UniqueRecipients      int     `json:"unique_recipients"`
+	Conversions           int     `json:"conversions"`
+	ConversionsBySendTime int     `json:"conversions_by_send_time"`
+	Enrolled              int     `json:"enrolled"`
+}
+
+type PushStatistics struct {
+	VariationStatistics
+	Sent        int `json:"sent"`
+	DirectOpens int `json:"direct_opens"`
+	TotalOpens  int `json:"total_opens"`
+	Bounces     int `json:"bounces"`
+	BodyClicks  int `json:"body_clicks"`
+}
+
+type EmailStatistics struct {
+	VariationStatistics
+	Sent         int `json:"sent"`
+	Delivered    int `json:"delivered"`
+	Opens        int `json:"opens"`
+	UniqueOpens  int `json:"unique_opens"`
+	Clicks       int `json:"clicks"`
+	UniqueClicks int `json:"unique_clicks"`
+	Unsubscribes int `json:"unsubscribes"`
+	Bounces      int `json:"bounces"`
+	ReportedSpam int `json:"reported_spam"`
+}
+
+type SMSStatistics struct {
+	VariationStatistics
+	Sent           int `json:"sent"`
+	SentToCarrier  int `json:"sent_to_carrier"`
+	Delivered      int `json:"delivered"`
+	Undelivered    int `json:"undelivered"`
+	Rejected       int `json:"rejected"`
+	DeliveryFailed int `json:"delivery_failed"`
+	Clicks         int `json:"clicks"`
+	OptOut         int `json:"opt_out"`
+	Help           int `json:"help"`
+}
+
+type WebhookStatistics struct {
+	VariationStatistics
+	Sent   int `json:"sent"`
+	Errors int `json:"errors"`
+}
+
+// ContentCardStatistics holds the performance of Content Cards. They are
+// reported per campaign by CampaignsEndpoint.DataSeries and per Canvas step by
+// CanvasEndpoint.DataSeries.
+type ContentCardStatistics struct {
+	VariationStatistics
+	Sent              int `json:"sent"`
+	TotalImpressions  int `json:"total_impressions"`
+	UniqueImpressions int `json:"unique_impressions"`
+	TotalClicks       int `json:"total_clicks"`
+	UniqueClicks      int `json:"unique_clicks"`
+	TotalDismissals   int `json:"total_dismissals"`
+	UniqueDismissals  int `json:"unique_dismissals"`
+}
+
+type InAppMessageStatistics struct {
+	VariationStatistics
+	Impressions        int `json:"impressions"`
+	Clicks             int `json:"clicks"`
+	FirstButtonClicks  int `json:"first_button_clicks"`
+	SecondButtonClicks int `json:"second_button_clicks"`
+}
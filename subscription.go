@@ -0,0 +1,296 @@
+package braze
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	subscriptionStatusSetPath   = "/subscription/status/set"
+	subscriptionStatusSetV2Path = "/v2/subscription/status/set"
+	subscriptionUserStatusPath  = "/subscription/user/status"
+	subscriptionStatusGetPath   = "/subscription/status/get"
+)
+
+var (
+	_ SubscriptionEndpoint = (*SubscriptionService)(nil)
+
+	SubscriptionStateSubscribed   SubscriptionState = "subscribed"
+	SubscriptionStateUnsubscribed SubscriptionState = "unsubscribed"
+
+	SubscriptionStatusSubscribed   SubscriptionStatus = "Subscribed"
+	SubscriptionStatusUnsubscribed SubscriptionStatus = "Unsubscribed"
+	SubscriptionStatusUnknown      SubscriptionStatus = "Unknown"
+)
+
+type SubscriptionEndpoint interface {
+	SetStatus(ctx context.Context, r *SubscriptionStatusSetRequest) (*Response, error)
+	SetStatusV2(ctx context.Context, r *SubscriptionStatusSetV2Request) (*Response, error)
+	UserStatus(ctx context.Context, r *SubscriptionUserStatusRequest) (*SubscriptionUserStatusResponse, error)
+	GetStatus(ctx context.Context, r *SubscriptionStatusGetRequest) (*SubscriptionStatusGetResponse, error)
+}
+
+type (
+	// SubscriptionState is the state set on a subscription group membership.
+	SubscriptionState string
+	// SubscriptionStatus is the status reported by Braze for a subscription
+	// group membership.
+	SubscriptionStatus string
+)
+
+type SubscriptionService struct {
+	client *Client
+}
+
+// https://www.braze.com/docs/api/endpoints/subscription_groups/post_update_user_subscription_group_status/
+//
+// One of ExternalIDs, Emails or Phones is required. Phone numbers must be in
+// E.164 format.
+type SubscriptionStatusSetRequest struct {
+	SubscriptionGroupID string            `json:"subscription_group_id"`
+	SubscriptionState   SubscriptionState `json:"subscription_state"`
+	ExternalIDs         []string          `json:"external_id,omitempty"`
+	Emails              []string          `json:"email,omitempty"`
+	Phones              []string          `json:"phone,omitempty"`
+}
+
+func (r *SubscriptionStatusSetRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.SubscriptionGroupID == "" {
+		return errors.New("subscription group ID must not be empty")
+	}
+
+	if r.SubscriptionState == "" {
+		return errors.New("subscription state must not be empty")
+	}
+
+	if len(r.ExternalIDs) == 0 && len(r.Emails) == 0 && len(r.Phones) == 0 {
+		return errors.New("one of external IDs, emails or phones must be set")
+	}
+
+	return nil
+}
+
+// https://www.braze.com/docs/api/endpoints/subscription_groups/post_update_user_subscription_group_status_v2/
+type SubscriptionStatusSetV2Request struct {
+	SubscriptionGroups []*SubscriptionGroupStatus `json:"subscription_groups"`
+}
+
+func (r *SubscriptionStatusSetV2Request) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if len(r.SubscriptionGroups) == 0 {
+		return errors.New("subscription groups must not be empty")
+	}
+
+	for _, g := range r.SubscriptionGroups {
+		if g == nil {
+			return errors.New("subscription group must not be nil")
+		}
+
+		if g.SubscriptionGroupID == "" {
+			return errors.New("subscription group ID must not be empty")
+		}
+
+		if g.SubscriptionState == "" {
+			return errors.New("subscription state must not be empty")
+		}
+
+		if len(g.ExternalIDs) == 0 && len(g.Emails) == 0 && len(g.Phones) == 0 {
+			return errors.New("one of external IDs, emails or phones must be set")
+		}
+	}
+
+	return nil
+}
+
+type SubscriptionGroupStatus struct {
+	SubscriptionGroupID string            `json:"subscription_group_id"`
+	SubscriptionState   SubscriptionState `json:"subscription_state"`
+	ExternalIDs         []string          `json:"external_ids,omitempty"`
+	Emails              []string          `json:"emails,omitempty"`
+	Phones              []string          `json:"phones,omitempty"`
+}
+
+// https://www.braze.com/docs/api/endpoints/subscription_groups/get_list_user_subscription_groups/
+type SubscriptionUserStatusRequest struct {
+	ExternalIDs []string
+	Emails      []string
+	Phones      []string
+	Limit       int
+	Offset      int
+}
+
+func (r *SubscriptionUserStatusRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if len(r.ExternalIDs) == 0 && len(r.Emails) == 0 && len(r.Phones) == 0 {
+		return errors.New("one of external IDs, emails or phones must be set")
+	}
+
+	return nil
+}
+
+func (r *SubscriptionUserStatusRequest) values() url.Values {
+	v := subscriptionIdentifierValues(r.ExternalIDs, r.Emails, r.Phones)
+	if r.Limit != 0 {
+		v.Set("limit", strconv.Itoa(r.Limit))
+	}
+	if r.Offset != 0 {
+		v.Set("offset", strconv.Itoa(r.Offset))
+	}
+	return v
+}
+
+type SubscriptionUserStatusResponse struct {
+	Message    string              `json:"message,omitempty"`
+	TotalCount int                 `json:"total_count,omitempty"`
+	Users      []*SubscriptionUser `json:"users,omitempty"`
+}
+
+type SubscriptionUser struct {
+	ExternalID         string                   `json:"external_id,omitempty"`
+	Email              string                   `json:"email,omitempty"`
+	Phone              string                   `json:"phone,omitempty"`
+	SubscriptionGroups []*UserSubscriptionGroup `json:"subscription_groups,omitempty"`
+}
+
+type UserSubscriptionGroup struct {
+	ID      string             `json:"id"`
+	Name    string             `json:"name"`
+	Channel string             `json:"channel"`
+	Status  SubscriptionStatus `json:"status"`
+}
+
+// https://www.braze.com/docs/api/endpoints/subscription_groups/get_list_user_subscription_group_status/
+type SubscriptionStatusGetRequest struct {
+	SubscriptionGroupID string
+	ExternalIDs         []string
+	Emails              []string
+	Phones              []string
+}
+
+func (r *SubscriptionStatusGetRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.SubscriptionGroupID == "" {
+		return errors.New("subscription group ID must not be empty")
+	}
+
+	if len(r.ExternalIDs) == 0 && len(r.Emails) == 0 && len(r.Phones) == 0 {
+		return errors.New("one of external IDs, emails or phones must be set")
+	}
+
+	return nil
+}
+
+func (r *SubscriptionStatusGetRequest) values() url.Values {
+	v := subscriptionIdentifierValues(r.ExternalIDs, r.Emails, r.Phones)
+	v.Set("subscription_group_id", r.SubscriptionGroupID)
+	return v
+}
+
+type SubscriptionStatusGetResponse struct {
+	Message string `json:"message,omitempty"`
+
+	// Subscription status keyed by the requested identifier.
+	Status map[string]SubscriptionStatus `json:"status,omitempty"`
+}
+
+func subscriptionIdentifierValues(externalIDs, emails, phones []string) url.Values {
+	v := url.Values{}
+	for _, id := range externalIDs {
+		v.Add("external_id[]", id)
+	}
+	for _, e := range emails {
+		v.Add("email[]", e)
+	}
+	for _, p := range phones {
+		v.Add("phone[]", p)
+	}
+	return v
+}
+
+func (s *SubscriptionService) SetStatus(ctx context.Context, r *SubscriptionStatusSetRequest) (*Response, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, subscriptionStatusSetPath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *SubscriptionService) SetStatusV2(ctx context.Context, r *SubscriptionStatusSetV2Request) (*Response, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, subscriptionStatusSetV2Path, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *SubscriptionService) UserStatus(ctx context.Context, r *SubscriptionUserStatusRequest) (*SubscriptionUserStatusResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, subscriptionUserStatusPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res SubscriptionUserStatusResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *SubscriptionService) GetStatus(ctx context.Context, r *SubscriptionStatusGetRequest) (*SubscriptionStatusGetResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, subscriptionStatusGetPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res SubscriptionStatusGetResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,87 @@
+package braze_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionServiceSetStatusV2(t *testing.T) {
+	srv, client := createTestServer(t, "/v2/subscription/status/set", func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"subscription_groups":[{"subscription_group_id":"g1","subscription_state":"subscribed","emails":["foo@dietdoctor.com"],"phones":["+46701234567"]}]}`, string(b))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"message":"success"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Subscription().SetStatusV2(context.Background(), &braze.SubscriptionStatusSetV2Request{
+		SubscriptionGroups: []*braze.SubscriptionGroupStatus{{
+			SubscriptionGroupID: "g1",
+			SubscriptionState:   braze.SubscriptionStateSubscribed,
+			Emails:              []string{"foo@dietdoctor.com"},
+			Phones:              []string{"+46701234567"},
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "success", resp.Message)
+}
+
+func TestSubscriptionServiceGetStatus(t *testing.T) {
+	srv, client := createTestServer(t, "/subscription/status/get", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "g1", r.URL.Query().Get("subscription_group_id"))
+		assert.Equal(t, []string{"1", "2"}, r.URL.Query()["external_id[]"])
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message":"success","status":{"1":"Subscribed","2":"Unknown"}}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Subscription().GetStatus(context.Background(), &braze.SubscriptionStatusGetRequest{
+		SubscriptionGroupID: "g1",
+		ExternalIDs:         []string{"1", "2"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]braze.SubscriptionStatus{
+		"1": braze.SubscriptionStatusSubscribed,
+		"2": braze.SubscriptionStatusUnknown,
+	}, resp.Status)
+}
+
+func TestSubscriptionServiceSetStatusMissingIdentifier(t *testing.T) {
+	srv, client := createTestServer(t, "/subscription/status/set", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.Subscription().SetStatus(context.Background(), &braze.SubscriptionStatusSetRequest{
+		SubscriptionGroupID: "g1",
+		SubscriptionState:   braze.SubscriptionStateUnsubscribed,
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestSubscriptionServiceSetStatusV2MissingState(t *testing.T) {
+	srv, client := createTestServer(t, "/v2/subscription/status/set", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.Subscription().SetStatusV2(context.Background(), &braze.SubscriptionStatusSetV2Request{
+		SubscriptionGroups: []*braze.SubscriptionGroupStatus{{
+			SubscriptionGroupID: "g1",
+			ExternalIDs:         []string{"123"},
+		}},
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,246 @@
+package braze
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	templatesEmailCreatePath = "/templates/email/create"
+	templatesEmailUpdatePath = "/templates/email/update"
+	templatesEmailListPath   = "/templates/email/list"
+	templatesEmailInfoPath   = "/templates/email/info"
+
+	templatesEmailListDefaultLimit = 100
+)
+
+type TemplatesEndpoint interface {
+	CreateEmailTemplate(ctx context.Context, r *EmailTemplateCreateRequest) (*EmailTemplateCreateResponse, error)
+	UpdateEmailTemplate(ctx context.Context, r *EmailTemplateUpdateRequest) (*Response, error)
+	ListEmailTemplates(ctx context.Context, r *EmailTemplateListRequest) (*EmailTemplateListResponse, error)
+	EmailTemplateInfo(ctx context.Context, templateID string) (*EmailTemplate, error)
+
+	// EmailTemplates returns an iterator over all templates matching the
+	// request, starting at its offset.
+	EmailTemplates(r *EmailTemplateListRequest) *Iterator[*EmailTemplateSummary]
+}
+
+var _ TemplatesEndpoint = (*TemplatesService)(nil)
+
+type TemplatesService struct {
+	client *Client
+}
+
+// https://www.braze.com/docs/api/endpoints/templates/email_templates/post_create_email_template/
+type EmailTemplateCreateRequest struct {
+	TemplateName    string   `json:"template_name"`
+	Subject         string   `json:"subject"`
+	Body            string   `json:"body"`
+	PlaintextBody   *string  `json:"plaintext_body,omitempty"`
+	PreHeader       *string  `json:"preheader,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	ShouldInlineCSS *bool    `json:"should_inline_css,omitempty"`
+}
+
+func (r *EmailTemplateCreateRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.TemplateName == "" {
+		return errors.New("template name must not be empty")
+	}
+
+	if r.Subject == "" {
+		return errors.New("subject must not be empty")
+	}
+
+	if r.Body == "" {
+		return errors.New("body must not be empty")
+	}
+
+	return nil
+}
+
+type EmailTemplateCreateResponse struct {
+	Message         string `json:"message,omitempty"`
+	EmailTemplateID string `json:"email_template_id,omitempty"`
+}
+
+// https://www.braze.com/docs/api/endpoints/templates/email_templates/post_update_email_template/
+//
+// Only the set fields are updated.
+type EmailTemplateUpdateRequest struct {
+	EmailTemplateID string   `json:"email_template_id"`
+	TemplateName    *string  `json:"template_name,omitempty"`
+	Subject         *string  `json:"subject,omitempty"`
+	Body            *string  `json:"body,omitempty"`
+	PlaintextBody   *string  `json:"plaintext_body,omitempty"`
+	PreHeader       *string  `json:"preheader,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	ShouldInlineCSS *bool    `json:"should_inline_css,omitempty"`
+}
+
+func (r *EmailTemplateUpdateRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.EmailTemplateID == "" {
+		return errors.New("email template ID must not be empty")
+	}
+
+	return nil
+}
+
+// https://www.braze.com/docs/api/endpoints/templates/email_templates/get_list_email_templates/
+type EmailTemplateListRequest struct {
+	ModifiedAfter  *time.Time
+	ModifiedBefore *time.Time
+
+	// Max number of templates to return, up to 1000. Defaults to 100.
+	Limit  int
+	Offset int
+}
+
+func (r *EmailTemplateListRequest) values() url.Values {
+	v := url.Values{}
+	if r.ModifiedAfter != nil {
+		v.Set("modified_after", r.ModifiedAfter.Format(time.RFC3339))
+	}
+	if r.ModifiedBefore != nil {
+		v.Set("modified_before", r.ModifiedBefore.Format(time.RFC3339))
+	}
+	if r.Limit != 0 {
+		v.Set("limit", strconv.Itoa(r.Limit))
+	}
+	if r.Offset != 0 {
+		v.Set("offset", strconv.Itoa(r.Offset))
+	}
+	return v
+}
+
+type EmailTemplateListResponse struct {
+	Message   string                  `json:"message,omitempty"`
+	Count     int                     `json:"count"`
+	Templates []*EmailTemplateSummary `json:"templates,omitempty"`
+}
+
+type EmailTemplateSummary struct {
+	EmailTemplateID string    `json:"email_template_id"`
+	TemplateName    string    `json:"template_name"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	Tags            []string  `json:"tags,omitempty"`
+}
+
+// https://www.braze.com/docs/api/endpoints/templates/email_templates/get_see_email_template_information/
+type EmailTemplate struct {
+	EmailTemplateID string    `json:"email_template_id"`
+	TemplateName    string    `json:"template_name"`
+	Description     string    `json:"description,omitempty"`
+	Subject         string    `json:"subject"`
+	PreHeader       string    `json:"preheader,omitempty"`
+	Body            string    `json:"body"`
+	PlaintextBody   string    `json:"plaintext_body,omitempty"`
+	ShouldInlineCSS bool      `json:"should_inline_css"`
+	Tags            []string  `json:"tags,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func (s *TemplatesService) CreateEmailTemplate(ctx context.Context, r *EmailTemplateCreateRequest) (*EmailTemplateCreateResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, templatesEmailCreatePath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res EmailTemplateCreateResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *TemplatesService) UpdateEmailTemplate(ctx context.Context, r *EmailTemplateUpdateRequest) (*Response, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, templatesEmailUpdatePath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Response
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *TemplatesService) ListEmailTemplates(ctx context.Context, r *EmailTemplateListRequest) (*EmailTemplateListResponse, error) {
+	if r == nil {
+		return nil, errors.New("request must not be nil")
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, templatesEmailListPath, r.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var res EmailTemplateListResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *TemplatesService) EmailTemplateInfo(ctx context.Context, templateID string) (*EmailTemplate, error) {
+	if templateID == "" {
+		return nil, errors.New("email template ID must not be empty")
+	}
+
+	req, err := s.client.http.newQueryRequest(http.MethodGet, templatesEmailInfoPath, url.Values{"email_template_id": {templateID}})
+	if err != nil {
+		return nil, err
+	}
+
+	var res EmailTemplate
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *TemplatesService) EmailTemplates(r *EmailTemplateListRequest) *Iterator[*EmailTemplateSummary] {
+	page := EmailTemplateListRequest{}
+	if r != nil {
+		page = *r
+	}
+	if page.Limit == 0 {
+		page.Limit = templatesEmailListDefaultLimit
+	}
+
+	return newIterator(func(ctx context.Context) ([]*EmailTemplateSummary, bool, error) {
+		res, err := s.ListEmailTemplates(ctx, &page)
+		if err != nil {
+			return nil, false, err
+		}
+		page.Offset += len(res.Templates)
+
+		return res.Templates, len(res.Templates) == page.Limit, nil
+	})
+}
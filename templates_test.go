@@ -0,0 +1,73 @@
+package braze_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dietdoctor/go-braze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplatesServiceCreateEmailTemplate(t *testing.T) {
+	srv, client := createTestServer(t, "/templates/email/create", func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"template_name":"welcome","subject":"Welcome","body":"<p>Hi</p>","tags":["onboarding"]}`, string(b))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"email_template_id":"t1","message":"success"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Templates().CreateEmailTemplate(context.Background(), &braze.EmailTemplateCreateRequest{
+		TemplateName: "welcome",
+		Subject:      "Welcome",
+		Body:         "<p>Hi</p>",
+		Tags:         []string{"onboarding"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "t1", resp.EmailTemplateID)
+}
+
+func TestTemplatesServiceEmailTemplatesIterator(t *testing.T) {
+	const total = 5
+	srv, client := createTestServer(t, "/templates/email/list", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		var templates []string
+		for i := offset; i < total && i < offset+2; i++ {
+			templates = append(templates, fmt.Sprintf(`{"email_template_id":"t%d","template_name":"n%d","created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"}`, i, i))
+		}
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"count":%d,"templates":[%s]}`, len(templates), strings.Join(templates, ","))
+	})
+	defer srv.Close()
+
+	it := client.Templates().EmailTemplates(&braze.EmailTemplateListRequest{Limit: 2})
+
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().EmailTemplateID)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"t0", "t1", "t2", "t3", "t4"}, ids)
+}
+
+func TestTemplatesServiceEmailTemplatesIteratorError(t *testing.T) {
+	srv, client := createTestServer(t, "/templates/email/list", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	defer srv.Close()
+
+	it := client.Templates().EmailTemplates(nil)
+	assert.False(t, it.Next(context.Background()))
+	assert.Error(t, it.Err())
+}
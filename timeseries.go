@@ -0,0 +1,117 @@
+package braze
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+var (
+	TimeSeriesUnitHour TimeSeriesUnit = "hour"
+	TimeSeriesUnitDay  TimeSeriesUnit = "day"
+)
+
+// TimeSeriesUnit is the granularity of a time series.
+type TimeSeriesUnit string
+
+// TimeSeriesRequest holds the parameters shared by the analytics exports.
+type TimeSeriesRequest struct {
+	// Max number of units before EndingAt to include in the returned series.
+	// Must be between 1 and 100 (inclusive), or 24 when Unit is hour.
+	Length int
+
+	// Either day or hour. Defaults to day.
+	Unit *TimeSeriesUnit
+
+	// Time on which the data series should end. Defaults to time of the request.
+	EndingAt *time.Time
+
+	// Restrict the series to a single app. Defaults to all apps.
+	AppID *string
+
+	// Restrict the series to users in the segment. The segment must have
+	// analytics tracking enabled.
+	SegmentID *string
+}
+
+func (r *TimeSeriesRequest) validate() error {
+	if r.Length < 1 || r.Length > 100 {
+		return errors.New("length must be between 1 and 100")
+	}
+
+	if r.Unit != nil && *r.Unit != TimeSeriesUnitDay && *r.Unit != TimeSeriesUnitHour {
+		return errors.New("unit must be either day or hour")
+	}
+
+	if r.Unit != nil && *r.Unit == TimeSeriesUnitHour && r.Length > 24 {
+		return errors.New("length must be between 1 and 24 for hourly series")
+	}
+
+	return nil
+}
+
+func (r *TimeSeriesRequest) values() url.Values {
+	v := url.Values{}
+	v.Set("length", strconv.Itoa(r.Length))
+	if r.Unit != nil {
+		v.Set("unit", string(*r.Unit))
+	}
+	if r.EndingAt != nil {
+		v.Set("ending_at", r.EndingAt.Format(time.RFC3339))
+	}
+	if r.AppID != nil {
+		v.Set("app_id", *r.AppID)
+	}
+	if r.SegmentID != nil {
+		v.Set("segment_id", *r.SegmentID)
+	}
+	return v
+}
+
+// TimeSeries is the result of the analytics exports returning a single value
+// per point in time.
+type TimeSeries struct {
+	Message string             `json:"message,omitempty"`
+	Data    []*TimeSeriesEntry `json:"data,omitempty"`
+}
+
+// TimeSeriesEntry is a single point of a TimeSeries.
+type TimeSeriesEntry struct {
+	// Date (yyyy-MM-dd) or time (yyyy-MM-ddTHH:mm:ss) of the entry depending on
+	// the unit of the series.
+	Time  string
+	Value float64
+}
+
+// timeSeriesResponse is the raw response of the analytics exports. Braze names
+// the value of each entry after the metric of the export (e.g. "dau" or
+// "count").
+type timeSeriesResponse struct {
+	Message string                       `json:"message,omitempty"`
+	Data    []map[string]json.RawMessage `json:"data,omitempty"`
+}
+
+// timeSeries returns the series of the metric. Other fields of the entries are
+// ignored.
+func (r *timeSeriesResponse) timeSeries(metric string) (*TimeSeries, error) {
+	ts := &TimeSeries{Message: r.Message}
+	for _, m := range r.Data {
+		var e TimeSeriesEntry
+		if err := json.Unmarshal(m["time"], &e.Time); err != nil {
+			return nil, fmt.Errorf("time series entry time: %w", err)
+		}
+
+		if v, ok := m[metric]; ok {
+			if err := json.Unmarshal(v, &e.Value); err != nil {
+				return nil, fmt.Errorf("time series entry %s: %w", metric, err)
+			}
+		}
+
+		ts.Data = append(ts.Data, &e)
+	}
+
+	return ts, nil
+}
@@ -4,17 +4,25 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"sync"
 )
 
 const (
-	usersTrackPath       = "/users/track"
-	usersCreateAliasPath = "/users/alias/new"
-	usersDeletePath      = "/users/delete"
-	usersIdentifyPath    = "/users/identify"
-	usersMergePath       = "/users/merge"
-	usersExportIdsPath   = "/users/export/ids"
+	usersTrackPath             = "/users/track"
+	usersCreateAliasPath       = "/users/alias/new"
+	usersDeletePath            = "/users/delete"
+	usersIdentifyPath          = "/users/identify"
+	usersMergePath             = "/users/merge"
+	usersExportIdsPath         = "/users/export/ids"
+	usersExportSegmentPath     = "/users/export/segment"
+	usersExternalIDsRenamePath = "/users/external_ids/rename"
+	usersExternalIDsRemovePath = "/users/external_ids/remove"
+
+	// Max number of external IDs Braze accepts in a single rename or removal
+	// request.
+	usersExternalIDsMaxPerRequest = 50
 )
 
 var (
@@ -28,6 +36,9 @@ var (
 	AttributeGenderOther           AttributeGender    = "O"
 	AttributeGenderNotApplicable   AttributeGender    = "N"
 	AttributeGenderPreferNotToSay  AttributeGender    = "P"
+
+	ExportOutputFormatZip  ExportOutputFormat = "zip"
+	ExportOutputFormatGzip ExportOutputFormat = "gzip"
 )
 
 type UsersEndpoint interface {
@@ -37,11 +48,21 @@ type UsersEndpoint interface {
 	CreateAlias(ctx context.Context, r *UsersCreateAliasRequest) (*Response, error)
 	Merge(ctx context.Context, r *UsersMergeRequest) (*Response, error)
 	ExportIds(ctx context.Context, r *UsersExportIdsRequest) (*UserExportResponse, error)
+	ExportSegment(ctx context.Context, r *UsersExportSegmentRequest) (*UsersExportSegmentResponse, error)
+	RenameExternalIDs(ctx context.Context, renames []*ExternalIDRename) (*ExternalIDsRenameResponse, error)
+	RemoveExternalIDs(ctx context.Context, externalIDs []string) (*ExternalIDsRemoveResponse, error)
+
+	// Batch variants accept any number of IDs and split them into requests of
+	// at most 50. On failure the results of the requests completed so far are
+	// returned together with the error.
+	RenameExternalIDsBatch(ctx context.Context, renames []*ExternalIDRename) (*ExternalIDsRenameResponse, error)
+	RemoveExternalIDsBatch(ctx context.Context, externalIDs []string) (*ExternalIDsRemoveResponse, error)
 }
 
 type (
 	AttributeSubscribe string
 	AttributeGender    string
+	ExportOutputFormat string
 )
 
 type UsersService struct {
@@ -73,6 +94,94 @@ type UsersExportIdsRequest struct {
 	FieldsToExport []string `json:"fields_to_export,omitempty"`
 }
 
+// https://www.braze.com/docs/api/endpoints/export/user_data/post_users_segment/
+type UsersExportSegmentRequest struct {
+	SegmentID string `json:"segment_id"`
+
+	// Endpoint to post a download URL to when the export is available.
+	CallbackEndpoint *string             `json:"callback_endpoint,omitempty"`
+	FieldsToExport   []string            `json:"fields_to_export"`
+	OutputFormat     *ExportOutputFormat `json:"output_format,omitempty"`
+}
+
+func (r *UsersExportSegmentRequest) validate() error {
+	if r == nil {
+		return errors.New("request must not be nil")
+	}
+
+	if r.SegmentID == "" {
+		return errors.New("segment ID must not be empty")
+	}
+
+	return nil
+}
+
+type UsersExportSegmentResponse struct {
+	Message      string `json:"message,omitempty"`
+	ObjectPrefix string `json:"object_prefix,omitempty"`
+
+	// Download URL of the export when no S3 or Azure credentials are set up.
+	URL string `json:"url,omitempty"`
+}
+
+// https://www.braze.com/docs/api/endpoints/user_data/external_id_migration/post_external_ids_rename/
+type ExternalIDRename struct {
+	CurrentExternalID string `json:"current_external_id"`
+	NewExternalID     string `json:"new_external_id"`
+}
+
+type ExternalIDsRenameResponse struct {
+	Message string `json:"message,omitempty"`
+
+	// New external IDs of the successful renames.
+	ExternalIDs  []string           `json:"external_ids,omitempty"`
+	RenameErrors []*ExternalIDError `json:"rename_errors,omitempty"`
+}
+
+// https://www.braze.com/docs/api/endpoints/user_data/external_id_migration/post_external_ids_remove/
+type ExternalIDsRemoveResponse struct {
+	Message       string             `json:"message,omitempty"`
+	RemovedIDs    []string           `json:"removed_ids,omitempty"`
+	RemovalErrors []*ExternalIDError `json:"removal_errors,omitempty"`
+}
+
+// ExternalIDError is a minor error of a single external ID. Braze reports
+// these as [external ID, message] pairs. Decoding is lenient: entries of an
+// unexpected shape are kept as raw JSON in Message rather than failing the
+// whole response.
+type ExternalIDError struct {
+	ExternalID string
+	Message    string
+}
+
+func (e *ExternalIDError) UnmarshalJSON(b []byte) error {
+	var pair []json.RawMessage
+	if err := json.Unmarshal(b, &pair); err != nil {
+		e.Message = jsonText(b)
+		return nil
+	}
+
+	if len(pair) > 0 {
+		e.ExternalID = jsonText(pair[0])
+	}
+	if len(pair) > 1 {
+		e.Message = jsonText(pair[1])
+	}
+
+	return nil
+}
+
+// jsonText returns the value of a JSON string, or the raw JSON text of any
+// other value.
+func jsonText(b []byte) string {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		return s
+	}
+
+	return string(b)
+}
+
 // https://www.braze.com/docs/api/objects_filters/user_attributes_object/
 type UserAttributes struct {
 	// Of the unique user identifier.
@@ -113,6 +222,10 @@ type UserAttributes struct {
 	Phone               *string             `json:"phone,omitempty"`
 	PushSubscribe       *AttributeSubscribe `json:"push_subscribe,omitempty"`
 
+	// Subscription group memberships to update, applied together with the
+	// other attributes.
+	SubscriptionGroups []*SubscriptionGroupUpdate `json:"subscription_groups,omitempty"`
+
 	// Array of objects with app_id and token string. You may optionally provide a
 	// device_id for the device this token is associated with, e.g., [{"app_id":
 	// App Identifier, "token": "abcd", "device_id": "optional_field_value"}]. If a
@@ -183,6 +296,11 @@ type PushToken struct {
 	DeviceID *string `json:"device_id,omitempty"`
 }
 
+type SubscriptionGroupUpdate struct {
+	SubscriptionGroupID string            `json:"subscription_group_id"`
+	SubscriptionState   SubscriptionState `json:"subscription_state"`
+}
+
 type AttributeTwitter struct {
 	ID             *string `json:"id,omitempty"`
 	FollowersCount *int    `json:"followers_count,omitempty"`
@@ -292,3 +410,105 @@ func (s *UsersService) ExportIds(ctx context.Context, r *UsersExportIdsRequest)
 
 	return &res, nil
 }
+
+func (s *UsersService) ExportSegment(ctx context.Context, r *UsersExportSegmentRequest) (*UsersExportSegmentResponse, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, usersExportSegmentPath, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res UsersExportSegmentResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *UsersService) RenameExternalIDs(ctx context.Context, renames []*ExternalIDRename) (*ExternalIDsRenameResponse, error) {
+	if len(renames) == 0 {
+		return nil, errors.New("renames must not be empty")
+	}
+
+	if len(renames) > usersExternalIDsMaxPerRequest {
+		return nil, fmt.Errorf("at most %d external IDs can be renamed in a single request", usersExternalIDsMaxPerRequest)
+	}
+
+	body := struct {
+		ExternalIDRenames []*ExternalIDRename `json:"external_id_renames"`
+	}{
+		ExternalIDRenames: renames,
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, usersExternalIDsRenamePath, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ExternalIDsRenameResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *UsersService) RemoveExternalIDs(ctx context.Context, externalIDs []string) (*ExternalIDsRemoveResponse, error) {
+	if len(externalIDs) == 0 {
+		return nil, errors.New("external IDs must not be empty")
+	}
+
+	if len(externalIDs) > usersExternalIDsMaxPerRequest {
+		return nil, fmt.Errorf("at most %d external IDs can be removed in a single request", usersExternalIDsMaxPerRequest)
+	}
+
+	body := struct {
+		ExternalIDs []string `json:"external_ids"`
+	}{
+		ExternalIDs: externalIDs,
+	}
+
+	req, err := s.client.http.newRequest(http.MethodPost, usersExternalIDsRemovePath, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ExternalIDsRemoveResponse
+	if err := s.client.http.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *UsersService) RenameExternalIDsBatch(ctx context.Context, renames []*ExternalIDRename) (*ExternalIDsRenameResponse, error) {
+	all := &ExternalIDsRenameResponse{}
+	for _, c := range chunk(renames, usersExternalIDsMaxPerRequest) {
+		res, err := s.RenameExternalIDs(ctx, c)
+		if err != nil {
+			return all, err
+		}
+		all.Message = res.Message
+		all.ExternalIDs = append(all.ExternalIDs, res.ExternalIDs...)
+		all.RenameErrors = append(all.RenameErrors, res.RenameErrors...)
+	}
+	return all, nil
+}
+
+func (s *UsersService) RemoveExternalIDsBatch(ctx context.Context, externalIDs []string) (*ExternalIDsRemoveResponse, error) {
+	all := &ExternalIDsRemoveResponse{}
+	for _, c := range chunk(externalIDs, usersExternalIDsMaxPerRequest) {
+		res, err := s.RemoveExternalIDs(ctx, c)
+		if err != nil {
+			return all, err
+		}
+		all.Message = res.Message
+		all.RemovedIDs = append(all.RemovedIDs, res.RemovedIDs...)
+		all.RemovalErrors = append(all.RemovalErrors, res.RemovalErrors...)
+	}
+	return all, nil
+}
@@ -2,10 +2,13 @@ package braze_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -131,6 +134,31 @@ func TestUsersServiceTrackGenericCustomAttributes(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func TestUsersServiceTrackSubscriptionGroups(t *testing.T) {
+	srv, client := createTestServer(t, "/users/track", func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(`{"attributes":[{"email":"foo@dietdoctor.com","external_id":"123","subscription_groups":[{"subscription_group_id":"g1","subscription_state":"subscribed"}]}]}`), b)
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Users().Track(context.Background(), &braze.UsersTrackRequest{
+		Attributes: []*braze.UserAttributes{{
+			ExternalID: braze.String("123"),
+			Email:      braze.String("foo@dietdoctor.com"),
+			SubscriptionGroups: []*braze.SubscriptionGroupUpdate{{
+				SubscriptionGroupID: "g1",
+				SubscriptionState:   braze.SubscriptionStateSubscribed,
+			}},
+		}},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+}
+
 func TestUsersServiceExportIdsUserExists(t *testing.T) {
 	srv, client := createTestServer(t, "/users/export/ids", func(w http.ResponseWriter, r *http.Request) {
 		b, err := io.ReadAll(r.Body)
@@ -175,3 +203,95 @@ func TestUsersServiceExportIdsUserDoesntExist(t *testing.T) {
 	}
 	assert.Equal(t, expected, resp)
 }
+
+func TestUsersServiceExportSegment(t *testing.T) {
+	srv, client := createTestServer(t, "/users/export/segment", func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(`{"segment_id":"s1","fields_to_export":["external_id","email"],"output_format":"gzip"}`), b)
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"message":"success","object_prefix":"prefix","url":"https://foo/export.gz"}`))
+	})
+	defer srv.Close()
+
+	resp, err := client.Users().ExportSegment(context.Background(), &braze.UsersExportSegmentRequest{
+		SegmentID:      "s1",
+		FieldsToExport: []string{"external_id", "email"},
+		OutputFormat:   &braze.ExportOutputFormatGzip,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://foo/export.gz", resp.URL)
+}
+
+func TestUsersServiceExportSegmentNilRequest(t *testing.T) {
+	srv, client := createTestServer(t, "/users/export/segment", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.Users().ExportSegment(context.Background(), nil)
+	assert.EqualError(t, err, "request must not be nil")
+	assert.Nil(t, resp)
+}
+
+func TestUsersServiceRenameExternalIDsBatch(t *testing.T) {
+	var calls int
+	srv, client := createTestServer(t, "/users/external_ids/rename", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		var body struct {
+			ExternalIDRenames []*braze.ExternalIDRename `json:"external_id_renames"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.LessOrEqual(t, len(body.ExternalIDRenames), 50)
+
+		var ids []string
+		for _, rename := range body.ExternalIDRenames[1:] {
+			ids = append(ids, `"`+rename.NewExternalID+`"`)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"message":"success","external_ids":[%s],"rename_errors":[["%s","user not found"]]}`, strings.Join(ids, ","), body.ExternalIDRenames[0].CurrentExternalID)
+	})
+	defer srv.Close()
+
+	renames := make([]*braze.ExternalIDRename, 75)
+	for i := range renames {
+		renames[i] = &braze.ExternalIDRename{
+			CurrentExternalID: fmt.Sprintf("old%d", i),
+			NewExternalID:     fmt.Sprintf("new%d", i),
+		}
+	}
+
+	resp, err := client.Users().RenameExternalIDsBatch(context.Background(), renames)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Len(t, resp.ExternalIDs, 73)
+	assert.Equal(t, []*braze.ExternalIDError{
+		{ExternalID: "old0", Message: "user not found"},
+		{ExternalID: "old50", Message: "user not found"},
+	}, resp.RenameErrors)
+}
+
+func TestUsersServiceRemoveExternalIDsTooMany(t *testing.T) {
+	srv, client := createTestServer(t, "/users/external_ids/remove", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer srv.Close()
+
+	resp, err := client.Users().RemoveExternalIDs(context.Background(), make([]string, 51))
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestExternalIDErrorUnmarshalJSONLenient(t *testing.T) {
+	var errs []*braze.ExternalIDError
+	err := json.Unmarshal([]byte(`[["old0","user not found"],["old1",{"code":1}],"invalid external ID",["old2"]]`), &errs)
+	require.NoError(t, err)
+	assert.Equal(t, []*braze.ExternalIDError{
+		{ExternalID: "old0", Message: "user not found"},
+		{ExternalID: "old1", Message: `{"code":1}`},
+		{Message: "invalid external ID"},
+		{ExternalID: "old2"},
+	}, errs)
+}